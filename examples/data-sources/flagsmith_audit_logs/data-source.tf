@@ -0,0 +1,11 @@
+# Changes made to the production environment in January 2024
+data "flagsmith_audit_logs" "production_changes" {
+  project_id     = 10
+  environment_id = 20
+  from           = "2024-01-01T00:00:00Z"
+  to             = "2024-02-01T00:00:00Z"
+}
+
+output "production_change_authors" {
+  value = distinct([for entry in data.flagsmith_audit_logs.production_changes.entries : entry.author if entry.author != null])
+}
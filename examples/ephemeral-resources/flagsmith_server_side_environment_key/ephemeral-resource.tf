@@ -0,0 +1,17 @@
+# The key is created for the duration of the run and deleted afterwards,
+# it's never stored in the state
+ephemeral "flagsmith_server_side_environment_key" "migration" {
+  environment_key = "<environment_key>"
+  name            = "schema-migration"
+  expires_at      = "2030-01-01T00:00:00Z"
+}
+
+resource "kubernetes_secret_v1" "migration" {
+  metadata {
+    name = "flagsmith-migration"
+  }
+  data_wo = {
+    FLAGSMITH_SERVER_SIDE_KEY = ephemeral.flagsmith_server_side_environment_key.migration.key
+  }
+  data_wo_revision = 1
+}
@@ -0,0 +1 @@
+terraform import flagsmith_environment_feature_version.checkout <environment_client_key>,<feature_id>
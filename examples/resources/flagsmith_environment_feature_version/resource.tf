@@ -0,0 +1,36 @@
+resource "flagsmith_environment" "production" {
+  name                      = "Production"
+  project_id                = 10
+  use_v2_feature_versioning = true
+}
+
+# Every change publishes the environment default and the segment overrides as a single new version
+resource "flagsmith_environment_feature_version" "checkout" {
+  environment_key = flagsmith_environment.production.api_key
+  feature_id      = 20
+  enabled         = true
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "classic"
+  }
+
+  # In order of priority, the first one has the highest priority
+  segment_overrides = [
+    {
+      segment_id = 30
+      enabled    = true
+      feature_state_value = {
+        type         = "unicode"
+        string_value = "one_click"
+      }
+    },
+    {
+      segment_id = 31
+      enabled    = false
+      feature_state_value = {
+        type         = "unicode"
+        string_value = "classic"
+      }
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import flagsmith_environment_integration.amplitude <environment_key>,<type>,<integration_id>
@@ -0,0 +1,16 @@
+resource "flagsmith_environment_integration" "amplitude" {
+  environment_key = "<environment_key>"
+  type            = "amplitude"
+  amplitude = {
+    api_key  = var.amplitude_api_key
+    base_url = "https://api.eu.amplitude.com"
+  }
+}
+
+resource "flagsmith_environment_integration" "mixpanel" {
+  environment_key = "<environment_key>"
+  type            = "mixpanel"
+  mixpanel = {
+    api_key = var.mixpanel_api_key
+  }
+}
@@ -0,0 +1,5 @@
+# Permissions of a user(the environment can be either the key or the uuid)
+terraform import flagsmith_environment_permission.development_admin <environment_key>,user,<user_id>
+
+# Permissions of a group
+terraform import flagsmith_environment_permission.production_on_call <environment_uuid>,group,<group_id>
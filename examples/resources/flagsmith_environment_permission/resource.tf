@@ -0,0 +1,13 @@
+# Only the on-call group may update feature states in production
+resource "flagsmith_environment_permission" "production_on_call" {
+  environment_key = "<production_environment_key>"
+  group_id        = flagsmith_user_group.on_call.id
+  permissions     = ["VIEW_ENVIRONMENT", "UPDATE_FEATURE_STATE"]
+}
+
+# The environment can also be referenced by uuid
+resource "flagsmith_environment_permission" "development_admin" {
+  environment_uuid = "4c2c3f8c-34f6-4a59-9e3d-a4e3b8f25a4e"
+  user_id          = 3936
+  admin            = true
+}
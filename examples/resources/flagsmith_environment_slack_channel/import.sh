@@ -0,0 +1 @@
+terraform import flagsmith_environment_slack_channel.production <environment_key>,<configuration_id>
@@ -0,0 +1,6 @@
+resource "flagsmith_environment_slack_channel" "production" {
+  environment_key = "<environment_key>"
+  channel_name    = "flags-production"
+
+  depends_on = [flagsmith_project_slack_integration.slack]
+}
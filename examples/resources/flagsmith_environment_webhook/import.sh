@@ -0,0 +1 @@
+terraform import flagsmith_environment_webhook.audit <environment_key>,<webhook_id>
@@ -0,0 +1,5 @@
+resource "flagsmith_environment_webhook" "audit" {
+  environment_key = "<environment_key>"
+  url             = "https://audit.example.com/flagsmith"
+  secret          = var.audit_webhook_secret
+}
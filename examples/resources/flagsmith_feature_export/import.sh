@@ -0,0 +1 @@
+terraform import flagsmith_feature_export.production <environment_key>,<feature_export_id>
@@ -0,0 +1,9 @@
+# Export of the features tagged `release` along with their values in production
+resource "flagsmith_feature_export" "production" {
+  environment_key = "<environment_key>"
+  tag_ids         = [flagsmith_tag.release.id]
+}
+
+output "production_features" {
+  value = flagsmith_feature_export.production.content
+}
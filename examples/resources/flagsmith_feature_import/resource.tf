@@ -0,0 +1,7 @@
+# Import the features exported from another Flagsmith instance(e.g: with `flagsmith_feature_export`),
+# features that already exist are left as they are
+resource "flagsmith_feature_import" "staging" {
+  environment_key = "<environment_key>"
+  strategy        = "SKIP"
+  content         = file("${path.module}/features.json")
+}
@@ -0,0 +1 @@
+terraform import flagsmith_feature_segment_overrides.checkout_flow <environment_key>,<feature_id>
@@ -0,0 +1,24 @@
+# Overrides of the `checkout_flow` feature in production, the first one has the highest priority
+resource "flagsmith_feature_segment_overrides" "checkout_flow" {
+  environment_key = "<environment_key>"
+  feature_id      = flagsmith_feature.checkout_flow.id
+
+  segment_overrides = [
+    {
+      segment_id = flagsmith_segment.beta_testers.id
+      enabled    = true
+      feature_state_value = {
+        type         = "unicode"
+        string_value = "new"
+      }
+    },
+    {
+      segment_id = flagsmith_segment.mobile_users.id
+      enabled    = false
+      feature_state_value = {
+        type         = "unicode"
+        string_value = "legacy"
+      }
+    },
+  ]
+}
@@ -46,3 +46,55 @@ resource "flagsmith_feature_state" "feature_1_dev_segment_override" {
     string_value = "segment_override_value"
   }
 }
+
+# Changes to production go through a change request
+resource "flagsmith_feature_state" "feature_1_prod" {
+  enabled         = true
+  environment_key = "<production_environment_key>"
+  feature_id      = flagsmith_feature.new_standard_feature.id
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "some_flag_value"
+  }
+  change_request = {
+    title              = "Enable new_standard_feature"
+    description        = "Opened by Terraform"
+    approver_group_ids = [flagsmith_user_group.release_managers.id]
+    wait_for_approval  = true
+    timeout            = "1h"
+  }
+}
+
+# Scheduled launch, the value goes live at 09:00 UTC
+resource "flagsmith_feature_state" "feature_1_launch" {
+  enabled         = true
+  environment_key = "<environment_key>"
+  feature_id      = flagsmith_feature.new_standard_feature.id
+  live_from       = "2030-01-07T09:00:00Z"
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "launched"
+  }
+}
+
+# Percentage of identities that get each multivariate option in the environment,
+# the remaining 20% get the control value
+resource "flagsmith_feature_state" "checkout_experiment_dev" {
+  enabled         = true
+  environment_key = "<environment_key>"
+  feature_id      = flagsmith_feature.checkout_experiment.id
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "control"
+  }
+  multivariate_values = [
+    {
+      multivariate_option_uuid = flagsmith_mv_feature_option.checkout_variant_a.uuid
+      percentage_allocation    = 50
+    },
+    {
+      multivariate_option_uuid = flagsmith_mv_feature_option.checkout_variant_b.uuid
+      percentage_allocation    = 30
+    },
+  ]
+}
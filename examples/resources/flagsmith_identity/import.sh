@@ -0,0 +1 @@
+terraform import flagsmith_identity.qa_user <environment_client_key>,<identifier>
@@ -0,0 +1,22 @@
+resource "flagsmith_identity" "qa_user" {
+  environment_key = "<environment_key>"
+  identifier      = "qa-user@example.com"
+  traits = {
+    plan = {
+      type         = "unicode"
+      string_value = "enterprise"
+    }
+    seats = {
+      type          = "int"
+      integer_value = 25
+    }
+    score = {
+      type        = "float"
+      float_value = 0.75
+    }
+    beta_tester = {
+      type          = "bool"
+      boolean_value = true
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import flagsmith_identity_feature_state.vip_customer_checkout <environment_client_key>,<identifier>,<feature_name>
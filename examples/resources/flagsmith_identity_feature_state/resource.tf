@@ -0,0 +1,15 @@
+resource "flagsmith_identity" "vip_customer" {
+  environment_key = "<environment_key>"
+  identifier      = "vip-customer@example.com"
+}
+
+resource "flagsmith_identity_feature_state" "vip_customer_checkout" {
+  environment_key = flagsmith_identity.vip_customer.environment_key
+  identifier      = flagsmith_identity.vip_customer.identifier
+  feature_id      = 1234
+  enabled         = true
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "express_checkout"
+  }
+}
@@ -0,0 +1,2 @@
+# The key itself can not be recovered after import
+terraform import flagsmith_master_api_key.ci <organisation_id>,<prefix>
@@ -0,0 +1,7 @@
+resource "flagsmith_master_api_key" "ci" {
+  organisation_id = 1
+  name            = "ci-2024-q3"
+  expiry_date     = "2024-10-01T00:00:00Z"
+  is_admin        = false
+  roles           = [flagsmith_role.release_manager.id]
+}
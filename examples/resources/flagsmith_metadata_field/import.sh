@@ -0,0 +1 @@
+terraform import flagsmith_metadata_field.ticket <organisation_id>,<field_id>
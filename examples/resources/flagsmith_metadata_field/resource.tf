@@ -0,0 +1,10 @@
+data "flagsmith_organisation" "my_organisation" {
+  uuid = "<organisation_uuid>"
+}
+
+resource "flagsmith_metadata_field" "ticket" {
+  organisation_id = data.flagsmith_organisation.my_organisation.id
+  name            = "ticket"
+  type            = "url"
+  description     = "Link to the ticket tracking the feature"
+}
@@ -0,0 +1 @@
+terraform import flagsmith_metadata_model_field.feature_ticket <organisation_id>,<model_field_id>
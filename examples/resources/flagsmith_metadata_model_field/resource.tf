@@ -0,0 +1,32 @@
+data "flagsmith_organisation" "my_organisation" {
+  uuid = "<organisation_uuid>"
+}
+
+resource "flagsmith_metadata_field" "ticket" {
+  organisation_id = data.flagsmith_organisation.my_organisation.id
+  name            = "ticket"
+  type            = "url"
+}
+
+# Every feature of the project must link to a ticket
+resource "flagsmith_metadata_model_field" "feature_ticket" {
+  organisation_id = data.flagsmith_organisation.my_organisation.id
+  field_id        = flagsmith_metadata_field.ticket.id
+  content_type    = "feature"
+  is_required_for = [
+    {
+      content_type = "project"
+      object_id    = 10
+    }
+  ]
+}
+
+resource "flagsmith_feature" "new_checkout" {
+  feature_name = "new_checkout"
+  project_uuid = "<project_uuid>"
+  type         = "STANDARD"
+  metadata = {
+    (flagsmith_metadata_field.ticket.name) = "https://tickets.example.com/FLAG-123"
+  }
+  depends_on = [flagsmith_metadata_model_field.feature_ticket]
+}
@@ -0,0 +1 @@
+terraform import flagsmith_organisation.my_organisation <organisation_uuid>
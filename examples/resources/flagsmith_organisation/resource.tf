@@ -0,0 +1,8 @@
+# Adopts the existing organisation, destroying the resource leaves the organisation untouched
+resource "flagsmith_organisation" "my_organisation" {
+  uuid                             = "<organisation_uuid>"
+  force_2fa                        = true
+  persist_trait_data               = true
+  restrict_project_create_to_admin = true
+  webhook_notification_email       = "alerts@example.com"
+}
@@ -0,0 +1 @@
+terraform import flagsmith_organisation_invite.new_engineer <organisation_id>,<invite_id>
@@ -0,0 +1,15 @@
+data "flagsmith_organisation" "my_organisation" {
+  uuid = "<organisation_uuid>"
+}
+
+resource "flagsmith_user_group" "engineering" {
+  organisation_id = data.flagsmith_organisation.my_organisation.id
+  name            = "Engineering"
+}
+
+resource "flagsmith_organisation_invite" "new_engineer" {
+  organisation_id = data.flagsmith_organisation.my_organisation.id
+  email           = "new.engineer@example.com"
+  role            = "USER"
+  groups          = [flagsmith_user_group.engineering.id]
+}
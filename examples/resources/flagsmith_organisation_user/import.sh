@@ -0,0 +1 @@
+terraform import flagsmith_organisation_user.lead_engineer <organisation_id>,<user_id>
@@ -0,0 +1,10 @@
+data "flagsmith_organisation" "my_organisation" {
+  uuid = "<organisation_uuid>"
+}
+
+# The user must already be a member of the organisation
+resource "flagsmith_organisation_user" "lead_engineer" {
+  organisation_id = data.flagsmith_organisation.my_organisation.id
+  email           = "lead.engineer@example.com"
+  role            = "ADMIN"
+}
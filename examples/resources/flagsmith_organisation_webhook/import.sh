@@ -0,0 +1 @@
+terraform import flagsmith_organisation_webhook.audit <organisation_id>,<webhook_id>
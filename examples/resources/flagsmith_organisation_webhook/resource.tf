@@ -0,0 +1,9 @@
+data "flagsmith_organisation" "my_organisation" {
+  uuid = "<organisation_uuid>"
+}
+
+resource "flagsmith_organisation_webhook" "audit" {
+  organisation_id = data.flagsmith_organisation.my_organisation.id
+  url             = "https://audit.example.com/flagsmith"
+  secret          = var.audit_webhook_secret
+}
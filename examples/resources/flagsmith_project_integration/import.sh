@@ -0,0 +1 @@
+terraform import flagsmith_project_integration.datadog <project_uuid>,<type>,<integration_id>
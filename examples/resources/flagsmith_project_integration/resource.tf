@@ -0,0 +1,18 @@
+resource "flagsmith_project_integration" "datadog" {
+  project_uuid = "<project_uuid>"
+  type         = "datadog"
+  datadog = {
+    api_key  = var.datadog_api_key
+    base_url = "https://api.datadoghq.eu/"
+  }
+}
+
+resource "flagsmith_project_integration" "new_relic" {
+  project_uuid = "<project_uuid>"
+  type         = "new_relic"
+  new_relic = {
+    api_key  = var.new_relic_api_key
+    base_url = "https://api.newrelic.com/"
+    app_id   = "<new_relic_app_id>"
+  }
+}
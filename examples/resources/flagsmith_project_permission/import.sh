@@ -0,0 +1,5 @@
+# Permissions of a user
+terraform import flagsmith_project_permission.developer <project_uuid>,user,<user_id>
+
+# Permissions of a group
+terraform import flagsmith_project_permission.platform_team <project_uuid>,group,<group_id>
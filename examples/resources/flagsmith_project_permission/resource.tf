@@ -0,0 +1,13 @@
+# Grant a set of permissions to a user
+resource "flagsmith_project_permission" "developer" {
+  project_uuid = "10421b1f-5f29-4da9-abe2-30f88c07c9e8"
+  user_id      = 3936
+  permissions  = ["CREATE_FEATURE", "DELETE_FEATURE", "MANAGE_SEGMENTS"]
+}
+
+# Make every member of a group an admin of the project
+resource "flagsmith_project_permission" "platform_team" {
+  project_uuid = "10421b1f-5f29-4da9-abe2-30f88c07c9e8"
+  group_id     = flagsmith_user_group.platform_team.id
+  admin        = true
+}
@@ -0,0 +1 @@
+terraform import flagsmith_project_slack_integration.slack <project_uuid>,<integration_id>
@@ -0,0 +1,5 @@
+resource "flagsmith_project_slack_integration" "slack" {
+  project_uuid = "<project_uuid>"
+  # Bot token of the Slack workspace, authorised outside of Terraform
+  api_token = var.slack_bot_token
+}
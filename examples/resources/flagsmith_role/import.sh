@@ -0,0 +1 @@
+terraform import flagsmith_role.release_manager <organisation_id>,<role_id>
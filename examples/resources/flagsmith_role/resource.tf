@@ -0,0 +1,5 @@
+resource "flagsmith_role" "release_manager" {
+  organisation_id = 1
+  name            = "Release Manager"
+  description     = "Can toggle features in every environment"
+}
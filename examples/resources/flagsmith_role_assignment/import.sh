@@ -0,0 +1,8 @@
+# Role assigned to a user
+terraform import flagsmith_role_assignment.release_manager_user <organisation_id>,<role_id>,user,<user_id>
+
+# Role assigned to a group
+terraform import flagsmith_role_assignment.release_manager_group <organisation_id>,<role_id>,group,<group_id>
+
+# Role assigned to a master API key
+terraform import flagsmith_role_assignment.ci <organisation_id>,<role_id>,master_api_key,<master_api_key_id>
@@ -0,0 +1,13 @@
+# Assign the role to a user
+resource "flagsmith_role_assignment" "release_manager_user" {
+  organisation_id = flagsmith_role.release_manager.organisation_id
+  role_id         = flagsmith_role.release_manager.id
+  user_id         = 3936
+}
+
+# Assign the role to every member of a group
+resource "flagsmith_role_assignment" "release_manager_group" {
+  organisation_id = flagsmith_role.release_manager.organisation_id
+  role_id         = flagsmith_role.release_manager.id
+  group_id        = flagsmith_user_group.release_managers.id
+}
@@ -0,0 +1 @@
+terraform import flagsmith_role_environment_permission.release_manager <organisation_id>,<role_id>,<environment_key>
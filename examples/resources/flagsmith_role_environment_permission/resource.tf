@@ -0,0 +1,6 @@
+resource "flagsmith_role_environment_permission" "release_manager" {
+  organisation_id = flagsmith_role.release_manager.organisation_id
+  role_id         = flagsmith_role.release_manager.id
+  environment_key = "<production_environment_key>"
+  permissions     = ["VIEW_ENVIRONMENT", "UPDATE_FEATURE_STATE"]
+}
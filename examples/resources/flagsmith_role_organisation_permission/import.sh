@@ -0,0 +1 @@
+terraform import flagsmith_role_organisation_permission.release_manager <organisation_id>,<role_id>
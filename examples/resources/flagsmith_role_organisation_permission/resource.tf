@@ -0,0 +1,5 @@
+resource "flagsmith_role_organisation_permission" "release_manager" {
+  organisation_id = flagsmith_role.release_manager.organisation_id
+  role_id         = flagsmith_role.release_manager.id
+  permissions     = ["CREATE_PROJECT"]
+}
@@ -0,0 +1 @@
+terraform import flagsmith_role_project_permission.release_manager <organisation_id>,<role_id>,<project_uuid>
@@ -0,0 +1,6 @@
+resource "flagsmith_role_project_permission" "release_manager" {
+  organisation_id = flagsmith_role.release_manager.organisation_id
+  role_id         = flagsmith_role.release_manager.id
+  project_uuid    = "10421b1f-5f29-4da9-abe2-30f88c07c9e8"
+  permissions     = ["VIEW_PROJECT", "CREATE_FEATURE"]
+}
@@ -0,0 +1 @@
+terraform import flagsmith_server_side_environment_key.backend <environment_key>,<key_id>
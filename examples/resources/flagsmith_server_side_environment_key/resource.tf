@@ -0,0 +1,18 @@
+resource "time_rotating" "backend_key" {
+  rotation_days = 90
+}
+
+resource "flagsmith_server_side_environment_key" "backend" {
+  environment_key = "<environment_key>"
+  name            = "backend"
+  expires_at      = "2030-01-01T00:00:00Z"
+
+  # A new key is created every time the rotation timestamp changes
+  keepers = {
+    rotation = time_rotating.backend_key.id
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
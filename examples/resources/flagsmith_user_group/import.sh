@@ -0,0 +1 @@
+terraform import flagsmith_user_group.engineering <organisation_id>,<group_id>
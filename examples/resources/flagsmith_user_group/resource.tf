@@ -0,0 +1,6 @@
+resource "flagsmith_user_group" "engineering" {
+  organisation_id = 1
+  name            = "Engineering"
+  external_id     = "cn=engineering,ou=groups,dc=example,dc=com"
+  users           = [3936, 12662]
+}
@@ -0,0 +1,130 @@
+package flagsmith
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/go-resty/resty/v2"
+)
+
+// Client wraps flagsmithapi.Client and adds the endpoints that are not
+// covered by the api client(yet). All the methods of the embedded client
+// are available as is.
+type Client struct {
+	*flagsmithapi.Client
+	baseURL string
+	client  *resty.Client
+}
+
+func NewClient(masterAPIKey string, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = BaseAPIURL
+	}
+	c := &Client{
+		Client:  flagsmithapi.NewClient(masterAPIKey, baseURL),
+		baseURL: baseURL,
+		client:  resty.New(),
+	}
+	c.client.SetHeaders(map[string]string{
+		"Accept":        "application/json",
+		"Content-type":  "application/json",
+		"Authorization": "Api-Key " + masterAPIKey,
+	})
+	return c
+}
+
+// NotFoundError is returned when the object being fetched does not exist(anymore)
+type NotFoundError struct {
+	kind string
+	id   string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("flagsmith: %s '%s' not found", e.kind, e.id)
+}
+
+func isNotFound(resp *resty.Response) bool {
+	return resp.StatusCode() == http.StatusNotFound
+}
+
+// decodeList decodes both paginated(i.e: `{"results": [...]}`) and plain list responses
+func decodeList(body []byte, result interface{}) error {
+	if len(bytes.TrimSpace(body)) > 0 && bytes.TrimSpace(body)[0] == '[' {
+		return json.Unmarshal(body, result)
+	}
+	page := struct {
+		Results json.RawMessage `json:"results"`
+	}{}
+	err := json.Unmarshal(body, &page)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(page.Results, result)
+}
+
+// nextPage returns the URL of the next page of a paginated response, if any
+func nextPage(body []byte) string {
+	page := struct {
+		Next *string `json:"next"`
+	}{}
+	// Plain list responses have no next page
+	if json.Unmarshal(body, &page) != nil || page.Next == nil {
+		return ""
+	}
+	return *page.Next
+}
+
+// getList fetches every page of a list endpoint and decodes the items of all of them into result.
+// The returned response is the last one fetched, hence it must be checked as for a single request
+func (c *Client) getList(request *resty.Request, url string, result interface{}) (*resty.Response, error) {
+	var items []json.RawMessage
+	for {
+		resp, err := request.Get(url)
+		if err != nil || !resp.IsSuccess() {
+			return resp, err
+		}
+		var page []json.RawMessage
+		err = decodeList(resp.Body(), &page)
+		if err != nil {
+			return resp, err
+		}
+		items = append(items, page...)
+		next := nextPage(resp.Body())
+		if next == "" {
+			body, err := json.Marshal(items)
+			if err != nil {
+				return resp, err
+			}
+			return resp, json.Unmarshal(body, result)
+		}
+		// The next page already includes the query params of the request
+		request, url = c.client.R(), next
+	}
+}
+
+type projectSettings struct {
+	ID                int64 `json:"id"`
+	UseEdgeIdentities bool  `json:"use_edge_identities"`
+}
+
+// IsEdgeEnvironment returns true if the project of the given environment
+// stores its identities in Edge(i.e: identities must be managed through the `edge-identities` endpoints)
+func (c *Client) IsEdgeEnvironment(environmentKey string) (bool, error) {
+	environment, err := c.GetEnvironment(environmentKey)
+	if err != nil {
+		return false, err
+	}
+	url := fmt.Sprintf("%s/projects/%d/", c.baseURL, environment.ProjectID)
+	project := projectSettings{}
+	resp, err := c.client.R().SetResult(&project).Get(url)
+	if err != nil {
+		return false, err
+	}
+	if !resp.IsSuccess() {
+		return false, fmt.Errorf("flagsmith: Error getting project: %s", resp)
+	}
+	return project.UseEdgeIdentities, nil
+}
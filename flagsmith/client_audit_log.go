@@ -0,0 +1,104 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type AuditLogAuthor struct {
+	ID    int64  `json:"id"`
+	Email string `json:"email"`
+}
+
+type auditLogObject struct {
+	ID int64 `json:"id"`
+}
+
+type AuditLog struct {
+	ID                int64           `json:"id"`
+	CreatedDate       string          `json:"created_date"`
+	Log               string          `json:"log"`
+	Author            *AuditLogAuthor `json:"author"`
+	Project           *auditLogObject `json:"project"`
+	Environment       *auditLogObject `json:"environment"`
+	RelatedObjectType *string         `json:"related_object_type"`
+	RelatedObjectUUID *string         `json:"related_object_uuid"`
+}
+
+// AuditLogQuery filters the audit logs, From and To are applied by the client as the API
+// does not support filtering by date
+type AuditLogQuery struct {
+	ProjectID     *int64
+	EnvironmentID *int64
+	Search        string
+	From          *time.Time
+	To            *time.Time
+}
+
+// matches returns whether the audit log was created within the date range of the query, and whether
+// older audit logs could still match
+func (q *AuditLogQuery) matches(log *AuditLog) (bool, bool, error) {
+	createdDate, err := time.Parse(time.RFC3339, log.CreatedDate)
+	if err != nil {
+		return false, false, fmt.Errorf("flagsmith: Error parsing created date of audit log %d: %s", log.ID, err)
+	}
+	if q.From != nil && createdDate.Before(*q.From) {
+		return false, false, nil
+	}
+	if q.To != nil && createdDate.After(*q.To) {
+		return false, true, nil
+	}
+	return true, true, nil
+}
+
+// GetAuditLogs returns the audit logs matching the query, newest first. Pages are fetched until the
+// audit logs are older than the date range of the query
+func (c *Client) GetAuditLogs(query *AuditLogQuery) ([]AuditLog, error) {
+	logs := []AuditLog{}
+	request := c.client.R()
+	if query.ProjectID != nil {
+		request.SetQueryParam("project", strconv.FormatInt(*query.ProjectID, 10))
+	}
+	if query.EnvironmentID != nil {
+		request.SetQueryParam("environments", strconv.FormatInt(*query.EnvironmentID, 10))
+	}
+	if query.Search != "" {
+		request.SetQueryParam("search", query.Search)
+	}
+	// getList is not used as it fetches every page, while the pages past the From bound
+	// of the query can be skipped
+	url := fmt.Sprintf("%s/audit/", c.baseURL)
+	for url != "" {
+		result := struct {
+			Results []AuditLog `json:"results"`
+			Next    *string    `json:"next"`
+		}{}
+		resp, err := request.SetResult(&result).Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.IsSuccess() {
+			return nil, fmt.Errorf("flagsmith: Error fetching audit logs: %s", resp)
+		}
+		url = ""
+		if result.Next != nil {
+			url = *result.Next
+			// The next URL already has the query parameters
+			request = c.client.R()
+		}
+		for i := range result.Results {
+			matches, more, err := query.matches(&result.Results[i])
+			if err != nil {
+				return nil, err
+			}
+			if matches {
+				logs = append(logs, result.Results[i])
+			}
+			if !more {
+				return logs, nil
+			}
+		}
+	}
+	return logs, nil
+}
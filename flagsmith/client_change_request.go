@@ -0,0 +1,89 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+type ChangeRequestFeatureState struct {
+	Feature           int64                           `json:"feature"`
+	Enabled           bool                            `json:"enabled"`
+	FeatureStateValue *flagsmithapi.FeatureStateValue `json:"feature_state_value"`
+	FeatureSegment    *int64                          `json:"feature_segment,omitempty"`
+	LiveFrom          *string                         `json:"live_from,omitempty"`
+
+	MultivariateFeatureStateValues []MultivariateFeatureStateValue `json:"multivariate_feature_state_values,omitempty"`
+}
+
+type ChangeRequestApproval struct {
+	User       int64   `json:"user"`
+	ApprovedAt *string `json:"approved_at,omitempty"`
+}
+
+type ChangeRequestGroupAssignment struct {
+	Group int64 `json:"group"`
+}
+
+type ChangeRequest struct {
+	ID               *int64                         `json:"id,omitempty"`
+	Title            string                         `json:"title"`
+	Description      string                         `json:"description"`
+	FeatureStates    []ChangeRequestFeatureState    `json:"feature_states"`
+	Approvals        []ChangeRequestApproval        `json:"approvals"`
+	GroupAssignments []ChangeRequestGroupAssignment `json:"group_assignments"`
+	CommittedAt      *string                        `json:"committed_at,omitempty"`
+}
+
+// ApprovalCount returns the number of approvals given so far, assigned
+// approvers that have not approved yet are not counted
+func (cr *ChangeRequest) ApprovalCount() int64 {
+	var count int64
+	for _, approval := range cr.Approvals {
+		if approval.ApprovedAt != nil {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *Client) CreateChangeRequest(environmentKey string, changeRequest *ChangeRequest) error {
+	url := fmt.Sprintf("%s/environments/%s/create-change-request/", c.baseURL, environmentKey)
+	resp, err := c.client.R().SetBody(changeRequest).SetResult(changeRequest).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating change request: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) GetChangeRequest(changeRequestID int64) (*ChangeRequest, error) {
+	url := fmt.Sprintf("%s/features/workflows/change-requests/%d/", c.baseURL, changeRequestID)
+	changeRequest := ChangeRequest{}
+	resp, err := c.client.R().SetResult(&changeRequest).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "change request", id: strconv.FormatInt(changeRequestID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching change request: %s", resp)
+	}
+	return &changeRequest, nil
+}
+
+func (c *Client) CommitChangeRequest(changeRequestID int64) error {
+	url := fmt.Sprintf("%s/features/workflows/change-requests/%d/commit/", c.baseURL, changeRequestID)
+	resp, err := c.client.R().Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error committing change request: %s", resp)
+	}
+	return nil
+}
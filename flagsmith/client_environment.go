@@ -0,0 +1,115 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// environmentSettings are the attributes of an environment that are not part of flagsmithapi.Environment
+type environmentSettings struct {
+	ID                     int64  `json:"id"`
+	UUID                   string `json:"uuid"`
+	UseV2FeatureVersioning bool   `json:"use_v2_feature_versioning"`
+}
+
+// v2VersioningTimeout is how long to wait for the migration of an environment to v2 feature versioning
+var v2VersioningTimeout = 5 * time.Minute
+
+func (c *Client) getEnvironmentSettings(environmentKey string) (*environmentSettings, error) {
+	url := fmt.Sprintf("%s/environments/%s/", c.baseURL, environmentKey)
+	environment := environmentSettings{}
+	resp, err := c.client.R().SetResult(&environment).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "environment", id: environmentKey}
+		}
+		return nil, fmt.Errorf("flagsmith: Error getting environment: %s", resp)
+	}
+	return &environment, nil
+}
+
+// UsesV2FeatureVersioning returns true if the feature states of the environment are managed
+// through feature versions(i.e: feature states can not be updated individually)
+func (c *Client) UsesV2FeatureVersioning(environmentKey string) (bool, error) {
+	environment, err := c.getEnvironmentSettings(environmentKey)
+	if err != nil {
+		return false, err
+	}
+	return environment.UseV2FeatureVersioning, nil
+}
+
+// EnableV2FeatureVersioning migrates the environment to v2 feature versioning and waits for the
+// migration to complete, unless ctx is done first. The migration can not be reverted
+func (c *Client) EnableV2FeatureVersioning(ctx context.Context, environmentKey string) error {
+	url := fmt.Sprintf("%s/environments/%s/enable-v2-versioning/", c.baseURL, environmentKey)
+	resp, err := c.client.R().Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error enabling v2 feature versioning: %s", resp)
+	}
+
+	// The migration is done asynchronously
+	deadline := time.Now().Add(v2VersioningTimeout)
+	for {
+		enabled, err := c.UsesV2FeatureVersioning(environmentKey)
+		if err != nil {
+			return err
+		}
+		if enabled {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("flagsmith: v2 feature versioning was not enabled within %s", v2VersioningTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("flagsmith: stopped waiting for v2 feature versioning to be enabled: %w", ctx.Err())
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// CloneEnvironment creates a copy of the source environment(including its feature states, segment overrides and
+// identity overrides) named after environment. The ID, UUID and API key of the copy are loaded into environment
+func (c *Client) CloneEnvironment(sourceEnvironmentKey string, environment *flagsmithapi.Environment) error {
+	url := fmt.Sprintf("%s/environments/%s/clone/", c.baseURL, sourceEnvironmentKey)
+	result := struct {
+		APIKey string `json:"api_key"`
+	}{}
+	resp, err := c.client.R().
+		SetBody(map[string]string{"name": environment.Name}).
+		SetResult(&result).
+		Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return NotFoundError{kind: "environment", id: sourceEnvironmentKey}
+		}
+		return fmt.Errorf("flagsmith: Error cloning environment: %s", resp)
+	}
+
+	// The clone endpoint only returns a subset of the environment
+	clone, err := c.GetEnvironment(result.APIKey)
+	if err != nil {
+		// Do not leave behind a copy that can not be managed
+		deleteErr := c.DeleteEnvironment(result.APIKey)
+		if deleteErr != nil {
+			return fmt.Errorf("flagsmith: Error reading cloned environment %q: %s, it could not be deleted either: %s", result.APIKey, err, deleteErr)
+		}
+		return err
+	}
+	environment.ID = clone.ID
+	environment.UUID = clone.UUID
+	environment.APIKey = clone.APIKey
+	return nil
+}
@@ -0,0 +1,74 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// environmentIntegrationTypes are the analytics integrations that can be configured
+// for an environment, the type is also the path of the integration in the API
+var environmentIntegrationTypes = []string{"amplitude", "segment", "mixpanel", "heap", "rudderstack"}
+
+type EnvironmentIntegration struct {
+	ID      *int64  `json:"id,omitempty"`
+	APIKey  string  `json:"api_key"`
+	BaseURL *string `json:"base_url"`
+}
+
+func (c *Client) environmentIntegrationsURL(environmentKey, integrationType string) string {
+	return fmt.Sprintf("%s/environments/%s/integrations/%s/", c.baseURL, environmentKey, integrationType)
+}
+
+func (c *Client) GetEnvironmentIntegration(environmentKey, integrationType string, integrationID int64) (*EnvironmentIntegration, error) {
+	url := fmt.Sprintf("%s%d/", c.environmentIntegrationsURL(environmentKey, integrationType), integrationID)
+	integration := EnvironmentIntegration{}
+	resp, err := c.client.R().SetResult(&integration).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: integrationType + " integration", id: strconv.FormatInt(integrationID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching %s integration: %s", integrationType, resp)
+	}
+	return &integration, nil
+}
+
+func (c *Client) CreateEnvironmentIntegration(environmentKey, integrationType string, integration *EnvironmentIntegration) error {
+	resp, err := c.client.R().
+		SetBody(integration).
+		SetResult(integration).
+		Post(c.environmentIntegrationsURL(environmentKey, integrationType))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating %s integration: %s", integrationType, resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateEnvironmentIntegration(environmentKey, integrationType string, integration *EnvironmentIntegration) error {
+	url := fmt.Sprintf("%s%d/", c.environmentIntegrationsURL(environmentKey, integrationType), *integration.ID)
+	resp, err := c.client.R().SetBody(integration).SetResult(integration).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating %s integration: %s", integrationType, resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteEnvironmentIntegration(environmentKey, integrationType string, integrationID int64) error {
+	url := fmt.Sprintf("%s%d/", c.environmentIntegrationsURL(environmentKey, integrationType), integrationID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting %s integration: %s", integrationType, resp)
+	}
+	return nil
+}
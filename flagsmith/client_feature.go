@@ -0,0 +1,46 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+func (c *Client) GetFeatureByName(projectID int64, featureName string) (*flagsmithapi.Feature, error) {
+	url := fmt.Sprintf("%s/projects/%d/features/", c.baseURL, projectID)
+	var features []flagsmithapi.Feature
+	request := c.client.R().SetQueryParam("search", featureName)
+	resp, err := c.getList(request, url, &features)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error searching feature: %s", resp)
+	}
+	// `search` does a partial match, hence we need to look for the exact name
+	for _, feature := range features {
+		if feature.Name == featureName {
+			feature.ProjectID = &projectID
+			return &feature, nil
+		}
+	}
+	return nil, NotFoundError{kind: "feature", id: featureName + " in project " + strconv.FormatInt(projectID, 10)}
+}
+
+// GetFeatureMVOptions returns the multivariate options of a feature
+func (c *Client) GetFeatureMVOptions(projectID, featureID int64) ([]flagsmithapi.FeatureMultivariateOption, error) {
+	url := fmt.Sprintf("%s/projects/%d/features/%d/mv-options/", c.baseURL, projectID, featureID)
+	var options []flagsmithapi.FeatureMultivariateOption
+	resp, err := c.getList(c.client.R(), url, &options)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "feature", id: strconv.FormatInt(featureID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching multivariate options: %s", resp)
+	}
+	return options, nil
+}
@@ -0,0 +1,191 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// featureImportStrategies are the ways of handling the features that already exist when importing features
+var featureImportStrategies = []string{"SKIP", "OVERWRITE_DESTRUCTIVE"}
+
+// FeatureExport is an export of the features of a project, along with their values in an environment, to JSON
+type FeatureExport struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	EnvironmentID int64  `json:"environment_id"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// FeatureImport is an import of the features of a feature export into an environment
+type FeatureImport struct {
+	ID            int64  `json:"id"`
+	EnvironmentID int64  `json:"environment_id"`
+	Strategy      string `json:"strategy"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// featureImportExportTimeout is how long to wait for a feature export(or import) to be processed
+var featureImportExportTimeout = 5 * time.Minute
+
+// featureImportExportPollInterval is the interval at which a feature export(or import) is checked while processing
+var featureImportExportPollInterval = 2 * time.Second
+
+// CreateFeatureExport starts exporting the features of the project of the environment, restricted to the given
+// tags if any. The export is processed asynchronously, see WaitForFeatureExport
+func (c *Client) CreateFeatureExport(environmentID int64, tagIDs []int64) (*FeatureExport, error) {
+	url := fmt.Sprintf("%s/features/create-feature-export/", c.baseURL)
+	body := struct {
+		EnvironmentID int64   `json:"environment_id"`
+		TagIDs        []int64 `json:"tag_ids"`
+	}{environmentID, tagIDs}
+	if body.TagIDs == nil {
+		body.TagIDs = []int64{}
+	}
+	featureExport := FeatureExport{}
+	resp, err := c.client.R().SetBody(body).SetResult(&featureExport).Post(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error creating feature export: %s", resp)
+	}
+	return &featureExport, nil
+}
+
+// GetFeatureExport returns a feature export of the project
+func (c *Client) GetFeatureExport(projectID, featureExportID int64) (*FeatureExport, error) {
+	var featureExports []FeatureExport
+	url := fmt.Sprintf("%s/features/feature-exports/%d/", c.baseURL, projectID)
+	resp, err := c.getList(c.client.R(), url, &featureExports)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching feature exports: %s", resp)
+	}
+	for i := range featureExports {
+		if featureExports[i].ID == featureExportID {
+			return &featureExports[i], nil
+		}
+	}
+	return nil, NotFoundError{kind: "feature export", id: strconv.FormatInt(featureExportID, 10)}
+}
+
+// DownloadFeatureExport returns the JSON of a processed feature export
+func (c *Client) DownloadFeatureExport(featureExportID int64) (string, error) {
+	url := fmt.Sprintf("%s/features/download-feature-export/%d/", c.baseURL, featureExportID)
+	resp, err := c.client.R().Get(url)
+	if err != nil {
+		return "", err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return "", NotFoundError{kind: "feature export", id: strconv.FormatInt(featureExportID, 10)}
+		}
+		return "", fmt.Errorf("flagsmith: Error downloading feature export: %s", resp)
+	}
+	return resp.String(), nil
+}
+
+// WaitForFeatureExport waits for the feature export to be processed, unless ctx is done first, and returns it
+func (c *Client) WaitForFeatureExport(ctx context.Context, projectID, featureExportID int64) (*FeatureExport, error) {
+	var featureExport *FeatureExport
+	err := waitForFeatureImportExport(ctx, "feature export", func() (string, error) {
+		var err error
+		featureExport, err = c.GetFeatureExport(projectID, featureExportID)
+		if err != nil {
+			return "", err
+		}
+		return featureExport.Status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return featureExport, nil
+}
+
+// CreateFeatureImport starts importing the JSON of a feature export into the environment. The import is processed
+// asynchronously, see WaitForFeatureImport
+func (c *Client) CreateFeatureImport(environmentID int64, strategy, content string) (*FeatureImport, error) {
+	url := fmt.Sprintf("%s/features/feature-import/%d", c.baseURL, environmentID)
+	featureImport := FeatureImport{}
+	resp, err := c.client.R().
+		SetFileReader("file", "features.json", strings.NewReader(content)).
+		SetFormData(map[string]string{"strategy": strategy}).
+		SetResult(&featureImport).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error creating feature import: %s", resp)
+	}
+	return &featureImport, nil
+}
+
+// GetFeatureImport returns a feature import of the project
+func (c *Client) GetFeatureImport(projectID, featureImportID int64) (*FeatureImport, error) {
+	var featureImports []FeatureImport
+	url := fmt.Sprintf("%s/features/feature-imports/%d/", c.baseURL, projectID)
+	resp, err := c.getList(c.client.R(), url, &featureImports)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching feature imports: %s", resp)
+	}
+	for i := range featureImports {
+		if featureImports[i].ID == featureImportID {
+			return &featureImports[i], nil
+		}
+	}
+	return nil, NotFoundError{kind: "feature import", id: strconv.FormatInt(featureImportID, 10)}
+}
+
+// WaitForFeatureImport waits for the feature import to be processed, unless ctx is done first, and returns it
+func (c *Client) WaitForFeatureImport(ctx context.Context, projectID, featureImportID int64) (*FeatureImport, error) {
+	var featureImport *FeatureImport
+	err := waitForFeatureImportExport(ctx, "feature import", func() (string, error) {
+		var err error
+		featureImport, err = c.GetFeatureImport(projectID, featureImportID)
+		if err != nil {
+			return "", err
+		}
+		return featureImport.Status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return featureImport, nil
+}
+
+// waitForFeatureImportExport polls the status of a feature export(or import) until it has been processed
+// or ctx is done
+func waitForFeatureImportExport(ctx context.Context, kind string, getStatus func() (string, error)) error {
+	deadline := time.Now().Add(featureImportExportTimeout)
+	for {
+		status, err := getStatus()
+		if err != nil {
+			return err
+		}
+		switch status {
+		case "SUCCESS":
+			return nil
+		case "FAILED":
+			return fmt.Errorf("flagsmith: %s failed", kind)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("flagsmith: %s was not processed within %s", kind, featureImportExportTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("flagsmith: stopped waiting for %s to be processed: %w", kind, ctx.Err())
+		case <-time.After(featureImportExportPollInterval):
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package flagsmith
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// getLiveFeatureStateUUIDs returns the UUIDs of the feature states of the environment default and of the segment
+// overrides that are currently live, keyed by feature segment ID(0 for the environment default). Committing a change
+// request or a scheduled change creates a new feature state, hence the feature states can not be looked up by UUID
+// once they have been changed that way
+func (c *Client) getLiveFeatureStateUUIDs(environmentKey string, featureID int64) (map[int64]string, error) {
+	url := fmt.Sprintf("%s/environments/%s/featurestates/", c.baseURL, environmentKey)
+	var featureStates []struct {
+		ID             int64  `json:"id"`
+		UUID           string `json:"uuid"`
+		Identity       *int64 `json:"identity"`
+		FeatureSegment *int64 `json:"feature_segment"`
+		LiveFrom       string `json:"live_from"`
+	}
+	request := c.client.R().SetQueryParam("feature", strconv.FormatInt(featureID, 10))
+	resp, err := c.getList(request, url, &featureStates)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching feature states: %s", resp)
+	}
+
+	now := time.Now()
+	liveUUIDs := map[int64]string{}
+	liveFrom := map[int64]time.Time{}
+	liveIDs := map[int64]int64{}
+	for _, featureState := range featureStates {
+		if featureState.Identity != nil {
+			continue
+		}
+		var featureSegmentID int64
+		if featureState.FeatureSegment != nil {
+			featureSegmentID = *featureState.FeatureSegment
+		}
+		// Feature states of uncommitted change requests do not have a live_from
+		from, err := time.Parse(time.RFC3339, featureState.LiveFrom)
+		if err != nil || from.After(now) {
+			continue
+		}
+		if _, ok := liveUUIDs[featureSegmentID]; !ok || from.After(liveFrom[featureSegmentID]) ||
+			(from.Equal(liveFrom[featureSegmentID]) && featureState.ID > liveIDs[featureSegmentID]) {
+			liveUUIDs[featureSegmentID] = featureState.UUID
+			liveFrom[featureSegmentID] = from
+			liveIDs[featureSegmentID] = featureState.ID
+		}
+	}
+	return liveUUIDs, nil
+}
+
+// GetLiveFeatureState returns the feature state of the environment(or of the segment override if featureSegmentID
+// is set) that is currently live
+func (c *Client) GetLiveFeatureState(environmentKey string, featureID int64, featureSegmentID *int64) (*flagsmithapi.FeatureState, error) {
+	liveUUIDs, err := c.getLiveFeatureStateUUIDs(environmentKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	var key int64
+	if featureSegmentID != nil {
+		key = *featureSegmentID
+	}
+	liveUUID, ok := liveUUIDs[key]
+	if !ok {
+		return nil, NotFoundError{kind: "live feature state", id: strconv.FormatInt(featureID, 10)}
+	}
+	featureState, err := c.GetFeatureState(liveUUID)
+	if err != nil {
+		return nil, err
+	}
+	featureState.EnvironmentKey = environmentKey
+	return featureState, nil
+}
+
+// GetSegmentOverrides returns the live feature states of the segment overrides of the feature in the environment,
+// in order of priority(i.e: highest priority first)
+func (c *Client) GetSegmentOverrides(environmentKey string, featureID int64) ([]*flagsmithapi.FeatureState, error) {
+	liveUUIDs, err := c.getLiveFeatureStateUUIDs(environmentKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	overrides := []*flagsmithapi.FeatureState{}
+	for featureSegmentID, liveUUID := range liveUUIDs {
+		if featureSegmentID == 0 {
+			continue
+		}
+		featureState, err := c.GetFeatureState(liveUUID)
+		if err != nil {
+			return nil, err
+		}
+		featureState.EnvironmentKey = environmentKey
+		overrides = append(overrides, featureState)
+	}
+	priority := func(featureState *flagsmithapi.FeatureState) int64 {
+		if featureState.SegmentPriority == nil {
+			return 0
+		}
+		return *featureState.SegmentPriority
+	}
+	sort.SliceStable(overrides, func(i, j int) bool {
+		return priority(overrides[i]) < priority(overrides[j])
+	})
+	return overrides, nil
+}
+
+type FeatureSegmentPriority struct {
+	ID       int64 `json:"id"`
+	Priority int64 `json:"priority"`
+}
+
+// UpdateFeatureSegmentPriorities sets the priorities of several feature segments at once
+func (c *Client) UpdateFeatureSegmentPriorities(priorities []FeatureSegmentPriority) error {
+	url := fmt.Sprintf("%s/features/feature-segments/update-priorities/", c.baseURL)
+	resp, err := c.client.R().SetBody(priorities).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating feature segment priorities: %s", resp)
+	}
+	return nil
+}
+
+// MultivariateFeatureStateValue is the percentage of identities that get a multivariate option in a
+// feature state(i.e: in the environment default or in a segment override)
+type MultivariateFeatureStateValue struct {
+	ID                        int64   `json:"id,omitempty"`
+	MultivariateFeatureOption int64   `json:"multivariate_feature_option"`
+	PercentageAllocation      float64 `json:"percentage_allocation"`
+}
+
+// GetMultivariateFeatureStateValues returns the multivariate values of a feature state
+func (c *Client) GetMultivariateFeatureStateValues(featureStateID int64) ([]MultivariateFeatureStateValue, error) {
+	url := fmt.Sprintf("%s/features/featurestates/%d/", c.baseURL, featureStateID)
+	result := struct {
+		MultivariateFeatureStateValues []MultivariateFeatureStateValue `json:"multivariate_feature_state_values"`
+	}{}
+	resp, err := c.client.R().SetResult(&result).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "feature state", id: strconv.FormatInt(featureStateID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching multivariate feature state values: %s", resp)
+	}
+	return result.MultivariateFeatureStateValues, nil
+}
+
+// UpdateMultivariateFeatureStateValues replaces the multivariate values of a feature state. Values with an ID are
+// updated, the others are created
+func (c *Client) UpdateMultivariateFeatureStateValues(featureStateID int64, values []MultivariateFeatureStateValue) error {
+	url := fmt.Sprintf("%s/features/featurestates/%d/", c.baseURL, featureStateID)
+	body := struct {
+		MultivariateFeatureStateValues []MultivariateFeatureStateValue `json:"multivariate_feature_state_values"`
+	}{values}
+	resp, err := c.client.R().SetBody(body).Patch(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating multivariate feature state values: %s", resp)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package flagsmith
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// FeatureVersion is a version of the feature states of a feature in an environment
+// using v2 feature versioning
+type FeatureVersion struct {
+	UUID        string  `json:"uuid"`
+	Published   bool    `json:"published"`
+	PublishedAt *string `json:"published_at"`
+	LiveFrom    *string `json:"live_from"`
+}
+
+// FeatureVersionFeatureState is a feature state that belongs to a feature version. The environment
+// default has no segment, segment overrides are identified by their segment instead of their ID
+type FeatureVersionFeatureState struct {
+	ID                int64
+	Enabled           bool
+	FeatureStateValue *flagsmithapi.FeatureStateValue
+	SegmentID         *int64
+	SegmentPriority   *int64
+
+	// featureSegmentID is only set if the API did not expand the feature segment
+	featureSegmentID *int64
+}
+
+type featureVersionFeatureSegment struct {
+	Segment  int64  `json:"segment"`
+	Priority *int64 `json:"priority,omitempty"`
+}
+
+func (fs *FeatureVersionFeatureState) MarshalJSON() ([]byte, error) {
+	var featureSegment *featureVersionFeatureSegment
+	if fs.SegmentID != nil {
+		featureSegment = &featureVersionFeatureSegment{Segment: *fs.SegmentID, Priority: fs.SegmentPriority}
+	}
+	return json.Marshal(struct {
+		Enabled           bool                            `json:"enabled"`
+		FeatureStateValue *flagsmithapi.FeatureStateValue `json:"feature_state_value"`
+		FeatureSegment    *featureVersionFeatureSegment   `json:"feature_segment"`
+	}{
+		Enabled:           fs.Enabled,
+		FeatureStateValue: fs.FeatureStateValue,
+		FeatureSegment:    featureSegment,
+	})
+}
+
+func (fs *FeatureVersionFeatureState) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		ID                int64                           `json:"id"`
+		Enabled           bool                            `json:"enabled"`
+		FeatureStateValue *flagsmithapi.FeatureStateValue `json:"feature_state_value"`
+		FeatureSegment    json.RawMessage                 `json:"feature_segment"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	fs.ID = obj.ID
+	fs.Enabled = obj.Enabled
+	fs.FeatureStateValue = obj.FeatureStateValue
+
+	// `feature_segment` is either the ID of the feature segment or the feature segment itself
+	if len(obj.FeatureSegment) == 0 || string(obj.FeatureSegment) == "null" {
+		return nil
+	}
+	var featureSegmentID int64
+	if json.Unmarshal(obj.FeatureSegment, &featureSegmentID) == nil {
+		fs.featureSegmentID = &featureSegmentID
+		return nil
+	}
+	var featureSegment featureVersionFeatureSegment
+	err = json.Unmarshal(obj.FeatureSegment, &featureSegment)
+	if err != nil {
+		return err
+	}
+	fs.SegmentID = &featureSegment.Segment
+	fs.SegmentPriority = featureSegment.Priority
+	return nil
+}
+
+// FeatureVersionChanges are the changes published as a single new version
+type FeatureVersionChanges struct {
+	PublishImmediately          bool                         `json:"publish_immediately"`
+	FeatureStatesToCreate       []FeatureVersionFeatureState `json:"feature_states_to_create"`
+	FeatureStatesToUpdate       []FeatureVersionFeatureState `json:"feature_states_to_update"`
+	SegmentIDsToDeleteOverrides []int64                      `json:"segment_ids_to_delete_overrides"`
+}
+
+func (c *Client) featureVersionsURL(environmentID, featureID int64) string {
+	return fmt.Sprintf("%s/environments/%d/features/%d/versions/", c.baseURL, environmentID, featureID)
+}
+
+// GetFeatureVersions returns the published versions of the feature that are live or have been live,
+// oldest first
+func (c *Client) GetFeatureVersions(environmentID, featureID int64) ([]FeatureVersion, error) {
+	var versions []FeatureVersion
+	url := c.featureVersionsURL(environmentID, featureID)
+	for url != "" {
+		result := struct {
+			Results []FeatureVersion `json:"results"`
+			Next    *string          `json:"next"`
+		}{}
+		resp, err := c.client.R().SetResult(&result).Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.IsSuccess() {
+			if isNotFound(resp) {
+				return nil, NotFoundError{kind: "feature versions", id: strconv.FormatInt(featureID, 10)}
+			}
+			return nil, fmt.Errorf("flagsmith: Error fetching feature versions: %s", resp)
+		}
+		versions = append(versions, result.Results...)
+		url = ""
+		if result.Next != nil {
+			url = *result.Next
+		}
+	}
+
+	now := time.Now()
+	liveVersions := []FeatureVersion{}
+	liveFrom := map[string]time.Time{}
+	for _, version := range versions {
+		if !version.Published || version.LiveFrom == nil {
+			continue
+		}
+		from, err := time.Parse(time.RFC3339, *version.LiveFrom)
+		if err != nil || from.After(now) {
+			continue
+		}
+		liveFrom[version.UUID] = from
+		liveVersions = append(liveVersions, version)
+	}
+	sort.SliceStable(liveVersions, func(i, j int) bool {
+		return liveFrom[liveVersions[i].UUID].Before(liveFrom[liveVersions[j].UUID])
+	})
+	return liveVersions, nil
+}
+
+// GetFeatureVersionFeatureStates returns the feature states of a version, i.e: the environment
+// default and the segment overrides
+func (c *Client) GetFeatureVersionFeatureStates(environmentID, featureID int64, versionUUID string) ([]FeatureVersionFeatureState, error) {
+	url := fmt.Sprintf("%s%s/featurestates/", c.featureVersionsURL(environmentID, featureID), versionUUID)
+	var featureStates []FeatureVersionFeatureState
+	resp, err := c.getList(c.client.R(), url, &featureStates)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "feature version", id: versionUUID}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching feature version feature states: %s", resp)
+	}
+	for i := range featureStates {
+		if featureStates[i].featureSegmentID == nil {
+			continue
+		}
+		featureSegment, err := c.GetFeatureSegmentByID(*featureStates[i].featureSegmentID)
+		if err != nil {
+			return nil, err
+		}
+		featureStates[i].SegmentID = featureSegment.Segment
+		featureStates[i].SegmentPriority = featureSegment.Priority
+	}
+	return featureStates, nil
+}
+
+// CreateFeatureVersion creates a new version of the feature with the given changes applied
+// to the feature states of the live version
+func (c *Client) CreateFeatureVersion(environmentID, featureID int64, changes *FeatureVersionChanges) (*FeatureVersion, error) {
+	version := FeatureVersion{}
+	resp, err := c.client.R().
+		SetBody(changes).
+		SetResult(&version).
+		Post(c.featureVersionsURL(environmentID, featureID))
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error creating feature version: %s", resp)
+	}
+	return &version, nil
+}
@@ -0,0 +1,158 @@
+package flagsmith
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+type EdgeIdentity struct {
+	IdentityUUID string `json:"identity_uuid,omitempty"`
+	Identifier   string `json:"identifier"`
+}
+
+type EdgeTrait struct {
+	TraitKey string `json:"trait_key"`
+	// Raw JSON value of the trait, `null` is used for deleting the trait
+	TraitValue json.RawMessage `json:"trait_value"`
+}
+
+// GetCoreIdentity is the same as flagsmithapi.Client.GetIdentity but returns
+// a NotFoundError if the identity does not exist
+func (c *Client) GetCoreIdentity(environmentKey string, identityID int64) (*flagsmithapi.Identity, error) {
+	url := fmt.Sprintf("%s/environments/%s/identities/%d/", c.baseURL, environmentKey, identityID)
+	identity := flagsmithapi.Identity{}
+	resp, err := c.client.R().SetResult(&identity).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "identity", id: strconv.FormatInt(identityID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching identity: %s", resp)
+	}
+	return &identity, nil
+}
+
+func (c *Client) GetCoreIdentityByIdentifier(environmentKey, identifier string) (*flagsmithapi.Identity, error) {
+	url := fmt.Sprintf("%s/environments/%s/identities/", c.baseURL, environmentKey)
+	var identities []flagsmithapi.Identity
+	request := c.client.R().SetQueryParam("q", identifier)
+	resp, err := c.getList(request, url, &identities)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error searching identity: %s", resp)
+	}
+	// `q` does a partial match, hence we need to look for the exact identifier
+	for _, identity := range identities {
+		if identity.Identifier == identifier {
+			return &identity, nil
+		}
+	}
+	return nil, NotFoundError{kind: "identity", id: identifier}
+}
+
+func (c *Client) GetEdgeIdentity(environmentKey, identityUUID string) (*EdgeIdentity, error) {
+	url := fmt.Sprintf("%s/environments/%s/edge-identities/%s/", c.baseURL, environmentKey, identityUUID)
+	identity := EdgeIdentity{}
+	resp, err := c.client.R().SetResult(&identity).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "edge identity", id: identityUUID}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching edge identity: %s", resp)
+	}
+	return &identity, nil
+}
+
+func (c *Client) GetEdgeIdentityByIdentifier(environmentKey, identifier string) (*EdgeIdentity, error) {
+	url := fmt.Sprintf("%s/environments/%s/edge-identities/", c.baseURL, environmentKey)
+	lastEvaluatedKey := ""
+	for {
+		result := struct {
+			Results          []EdgeIdentity `json:"results"`
+			LastEvaluatedKey *string        `json:"last_evaluated_key"`
+		}{}
+		request := c.client.R().
+			SetQueryParam("q", identifier).
+			SetResult(&result)
+		// Edge identities are paginated by the key of the last identity of the previous page instead of `next`
+		if lastEvaluatedKey != "" {
+			request.SetQueryParam("last_evaluated_key", lastEvaluatedKey)
+		}
+		resp, err := request.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.IsSuccess() {
+			return nil, fmt.Errorf("flagsmith: Error searching edge identity: %s", resp)
+		}
+		for _, identity := range result.Results {
+			if identity.Identifier == identifier {
+				return &identity, nil
+			}
+		}
+		if result.LastEvaluatedKey == nil || *result.LastEvaluatedKey == "" {
+			return nil, NotFoundError{kind: "edge identity", id: identifier}
+		}
+		lastEvaluatedKey = *result.LastEvaluatedKey
+	}
+}
+
+func (c *Client) CreateEdgeIdentity(environmentKey string, identity *EdgeIdentity) error {
+	url := fmt.Sprintf("%s/environments/%s/edge-identities/", c.baseURL, environmentKey)
+	resp, err := c.client.R().SetBody(identity).SetResult(identity).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating edge identity: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteEdgeIdentity(environmentKey, identityUUID string) error {
+	url := fmt.Sprintf("%s/environments/%s/edge-identities/%s/", c.baseURL, environmentKey, identityUUID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting edge identity: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) GetEdgeIdentityTraits(environmentKey, identityUUID string) ([]EdgeTrait, error) {
+	url := fmt.Sprintf("%s/environments/%s/edge-identities/%s/list-traits/", c.baseURL, environmentKey, identityUUID)
+	traits := []EdgeTrait{}
+	resp, err := c.client.R().SetResult(&traits).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching edge identity traits: %s", resp)
+	}
+	return traits, nil
+}
+
+// UpdateEdgeIdentityTrait creates, updates or(if the value is `null`) deletes a trait
+func (c *Client) UpdateEdgeIdentityTrait(environmentKey, identityUUID string, trait *EdgeTrait) error {
+	url := fmt.Sprintf("%s/environments/%s/edge-identities/%s/update-traits/", c.baseURL, environmentKey, identityUUID)
+	resp, err := c.client.R().SetBody(trait).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating edge identity trait: %s", resp)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package flagsmith
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// IdentityFeatureState represents an identity override, i.e: a feature state
+// that only applies to a single identity. The identity endpoints(both Core and Edge)
+// use the raw value of the feature state instead of the `FeatureStateValue` object
+type IdentityFeatureState struct {
+	ID                int64
+	UUID              string
+	Feature           int64
+	Enabled           bool
+	FeatureStateValue *flagsmithapi.FeatureStateValue
+}
+
+func (fs *IdentityFeatureState) MarshalJSON() ([]byte, error) {
+	var value interface{}
+	if fs.FeatureStateValue != nil {
+		switch fs.FeatureStateValue.Type {
+		case "unicode":
+			value = fs.FeatureStateValue.StringValue
+		case "int":
+			value = fs.FeatureStateValue.IntegerValue
+		case "bool":
+			value = fs.FeatureStateValue.BooleanValue
+		}
+	}
+	return json.Marshal(struct {
+		Feature           int64       `json:"feature"`
+		Enabled           bool        `json:"enabled"`
+		FeatureStateValue interface{} `json:"feature_state_value"`
+	}{
+		Feature:           fs.Feature,
+		Enabled:           fs.Enabled,
+		FeatureStateValue: value,
+	})
+}
+
+func (fs *IdentityFeatureState) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		ID                int64           `json:"id"`
+		UUID              string          `json:"uuid"`
+		FeatureStateUUID  string          `json:"featurestate_uuid"`
+		Feature           int64           `json:"feature"`
+		Enabled           bool            `json:"enabled"`
+		FeatureStateValue json.RawMessage `json:"feature_state_value"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	fs.ID = obj.ID
+	fs.UUID = obj.UUID
+	// Edge feature states are identified by `featurestate_uuid`
+	if obj.FeatureStateUUID != "" {
+		fs.UUID = obj.FeatureStateUUID
+	}
+	fs.Feature = obj.Feature
+	fs.Enabled = obj.Enabled
+
+	decoder := json.NewDecoder(bytes.NewReader(obj.FeatureStateValue))
+	decoder.UseNumber()
+	var value interface{}
+	_ = decoder.Decode(&value)
+
+	switch v := value.(type) {
+	case bool:
+		fs.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "bool", BooleanValue: &v}
+	case json.Number:
+		intValue, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("flagsmith: Unexpected feature state value: %s", v)
+		}
+		fs.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &intValue}
+	case string:
+		fs.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &v}
+	default:
+		fs.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode"}
+	}
+	return nil
+}
+
+func (c *Client) coreIdentityFeatureStatesURL(environmentKey string, identityID int64) string {
+	return fmt.Sprintf("%s/environments/%s/identities/%d/featurestates/", c.baseURL, environmentKey, identityID)
+}
+
+func (c *Client) edgeIdentityFeatureStatesURL(environmentKey, identityUUID string) string {
+	return fmt.Sprintf("%s/environments/%s/edge-identities/%s/edge-featurestates/", c.baseURL, environmentKey, identityUUID)
+}
+
+func (c *Client) GetCoreIdentityFeatureState(environmentKey string, identityID, featureStateID int64) (*IdentityFeatureState, error) {
+	url := fmt.Sprintf("%s%d/", c.coreIdentityFeatureStatesURL(environmentKey, identityID), featureStateID)
+	featureState := IdentityFeatureState{}
+	resp, err := c.client.R().SetResult(&featureState).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "identity feature state", id: strconv.FormatInt(featureStateID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching identity feature state: %s", resp)
+	}
+	return &featureState, nil
+}
+
+func (c *Client) GetCoreIdentityFeatureStateByFeature(environmentKey string, identityID, featureID int64) (*IdentityFeatureState, error) {
+	var featureStates []IdentityFeatureState
+	request := c.client.R().SetQueryParam("feature", strconv.FormatInt(featureID, 10))
+	resp, err := c.getList(request, c.coreIdentityFeatureStatesURL(environmentKey, identityID), &featureStates)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching identity feature states: %s", resp)
+	}
+	for _, featureState := range featureStates {
+		if featureState.Feature == featureID {
+			return &featureState, nil
+		}
+	}
+	return nil, NotFoundError{kind: "identity feature state", id: strconv.FormatInt(featureID, 10)}
+}
+
+func (c *Client) CreateCoreIdentityFeatureState(environmentKey string, identityID int64, featureState *IdentityFeatureState) error {
+	resp, err := c.client.R().
+		SetBody(featureState).
+		SetResult(featureState).
+		Post(c.coreIdentityFeatureStatesURL(environmentKey, identityID))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating identity feature state: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateCoreIdentityFeatureState(environmentKey string, identityID int64, featureState *IdentityFeatureState) error {
+	url := fmt.Sprintf("%s%d/", c.coreIdentityFeatureStatesURL(environmentKey, identityID), featureState.ID)
+	resp, err := c.client.R().SetBody(featureState).SetResult(featureState).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating identity feature state: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteCoreIdentityFeatureState(environmentKey string, identityID, featureStateID int64) error {
+	url := fmt.Sprintf("%s%d/", c.coreIdentityFeatureStatesURL(environmentKey, identityID), featureStateID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting identity feature state: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) GetEdgeIdentityFeatureState(environmentKey, identityUUID, featureStateUUID string) (*IdentityFeatureState, error) {
+	url := fmt.Sprintf("%s%s/", c.edgeIdentityFeatureStatesURL(environmentKey, identityUUID), featureStateUUID)
+	featureState := IdentityFeatureState{}
+	resp, err := c.client.R().SetResult(&featureState).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "edge identity feature state", id: featureStateUUID}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching edge identity feature state: %s", resp)
+	}
+	return &featureState, nil
+}
+
+func (c *Client) GetEdgeIdentityFeatureStateByFeature(environmentKey, identityUUID string, featureID int64) (*IdentityFeatureState, error) {
+	var featureStates []IdentityFeatureState
+	request := c.client.R().SetQueryParam("feature", strconv.FormatInt(featureID, 10))
+	resp, err := c.getList(request, c.edgeIdentityFeatureStatesURL(environmentKey, identityUUID), &featureStates)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching edge identity feature states: %s", resp)
+	}
+	for _, featureState := range featureStates {
+		if featureState.Feature == featureID {
+			return &featureState, nil
+		}
+	}
+	return nil, NotFoundError{kind: "edge identity feature state", id: strconv.FormatInt(featureID, 10)}
+}
+
+func (c *Client) CreateEdgeIdentityFeatureState(environmentKey, identityUUID string, featureState *IdentityFeatureState) error {
+	resp, err := c.client.R().
+		SetBody(featureState).
+		SetResult(featureState).
+		Post(c.edgeIdentityFeatureStatesURL(environmentKey, identityUUID))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating edge identity feature state: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateEdgeIdentityFeatureState(environmentKey, identityUUID string, featureState *IdentityFeatureState) error {
+	url := fmt.Sprintf("%s%s/", c.edgeIdentityFeatureStatesURL(environmentKey, identityUUID), featureState.UUID)
+	resp, err := c.client.R().SetBody(featureState).SetResult(featureState).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating edge identity feature state: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteEdgeIdentityFeatureState(environmentKey, identityUUID, featureStateUUID string) error {
+	url := fmt.Sprintf("%s%s/", c.edgeIdentityFeatureStatesURL(environmentKey, identityUUID), featureStateUUID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting edge identity feature state: %s", resp)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package flagsmith
+
+import (
+	"fmt"
+	"time"
+)
+
+type MasterAPIKey struct {
+	ID             string     `json:"id,omitempty"`
+	Prefix         string     `json:"prefix,omitempty"`
+	Name           string     `json:"name"`
+	Key            string     `json:"key,omitempty"`
+	ExpiryDate     *time.Time `json:"expiry_date"`
+	IsAdmin        bool       `json:"is_admin"`
+	Revoked        bool       `json:"revoked,omitempty"`
+	OrganisationID int64      `json:"organisation"`
+}
+
+func (c *Client) masterAPIKeysURL(organisationID int64) string {
+	return fmt.Sprintf("%s/organisations/%d/master-api-keys/", c.baseURL, organisationID)
+}
+
+// GetMasterAPIKey returns the master API key identified by the given prefix.
+// Revoked keys are reported as not found
+func (c *Client) GetMasterAPIKey(organisationID int64, prefix string) (*MasterAPIKey, error) {
+	url := fmt.Sprintf("%s%s/", c.masterAPIKeysURL(organisationID), prefix)
+	key := MasterAPIKey{}
+	resp, err := c.client.R().SetResult(&key).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "master api key", id: prefix}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching master api key: %s", resp)
+	}
+	if key.Revoked {
+		return nil, NotFoundError{kind: "master api key", id: prefix}
+	}
+	return &key, nil
+}
+
+func (c *Client) CreateMasterAPIKey(key *MasterAPIKey) error {
+	resp, err := c.client.R().SetBody(key).SetResult(key).Post(c.masterAPIKeysURL(key.OrganisationID))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating master api key: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateMasterAPIKey(key *MasterAPIKey) error {
+	url := fmt.Sprintf("%s%s/", c.masterAPIKeysURL(key.OrganisationID), key.Prefix)
+	resp, err := c.client.R().SetBody(key).SetResult(key).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating master api key: %s", resp)
+	}
+	return nil
+}
+
+// RevokeMasterAPIKey revokes the key(i.e: the key is not deleted but can not be used anymore)
+func (c *Client) RevokeMasterAPIKey(organisationID int64, prefix string) error {
+	url := fmt.Sprintf("%s%s/", c.masterAPIKeysURL(organisationID), prefix)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error revoking master api key: %s", resp)
+	}
+	return nil
+}
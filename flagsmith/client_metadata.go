@@ -0,0 +1,359 @@
+package flagsmith
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// metadataFieldTypes are the types a metadata field value can have
+var metadataFieldTypes = []string{"int", "str", "bool", "url", "multiline_str"}
+
+// metadataContentTypes are the models metadata can be attached to
+var metadataContentTypes = []string{"feature", "segment", "environment"}
+
+type MetadataField struct {
+	ID             *int64 `json:"id,omitempty"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Description    string `json:"description"`
+	OrganisationID int64  `json:"organisation"`
+}
+
+// MetadataModelFieldRequirement makes a metadata model field required for the objects of an
+// organisation or a project
+type MetadataModelFieldRequirement struct {
+	ContentType int64 `json:"content_type"`
+	ObjectID    int64 `json:"object_id"`
+}
+
+// MetadataModelField attaches a metadata field to a content type(e.g: features)
+type MetadataModelField struct {
+	ID            *int64                          `json:"id,omitempty"`
+	Field         int64                           `json:"field"`
+	ContentType   int64                           `json:"content_type"`
+	IsRequiredFor []MetadataModelFieldRequirement `json:"is_required_for"`
+}
+
+// ContentType is a model as identified by the API, e.g: `{"app_label": "features", "model": "feature"}`
+type ContentType struct {
+	ID       int64  `json:"id"`
+	AppLabel string `json:"app_label"`
+	Model    string `json:"model"`
+}
+
+// Metadata is the value of a metadata field on a feature, a segment or an environment
+type Metadata struct {
+	ID         *int64 `json:"id,omitempty"`
+	ModelField int64  `json:"model_field"`
+	FieldValue string `json:"field_value"`
+}
+
+// ContentTypeMetadataField is a metadata field of an organisation along with the ID of the model field
+// that attaches it to a given content type, if any
+type ContentTypeMetadataField struct {
+	Field        MetadataField
+	ModelFieldID *int64
+}
+
+func (c *Client) metadataFieldsURL() string {
+	return fmt.Sprintf("%s/metadata/fields/", c.baseURL)
+}
+
+func (c *Client) metadataModelFieldsURL(organisationID int64) string {
+	return fmt.Sprintf("%s/organisations/%d/metadata-model-fields/", c.baseURL, organisationID)
+}
+
+func (c *Client) GetMetadataField(fieldID int64) (*MetadataField, error) {
+	url := fmt.Sprintf("%s%d/", c.metadataFieldsURL(), fieldID)
+	field := MetadataField{}
+	resp, err := c.client.R().SetResult(&field).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "metadata field", id: strconv.FormatInt(fieldID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching metadata field: %s", resp)
+	}
+	return &field, nil
+}
+
+func (c *Client) GetMetadataFields(organisationID int64) ([]MetadataField, error) {
+	var fields []MetadataField
+	request := c.client.R().SetQueryParam("organisation", strconv.FormatInt(organisationID, 10))
+	resp, err := c.getList(request, c.metadataFieldsURL(), &fields)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching metadata fields: %s", resp)
+	}
+	return fields, nil
+}
+
+func (c *Client) CreateMetadataField(field *MetadataField) error {
+	resp, err := c.client.R().SetBody(field).SetResult(field).Post(c.metadataFieldsURL())
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating metadata field: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateMetadataField(field *MetadataField) error {
+	url := fmt.Sprintf("%s%d/", c.metadataFieldsURL(), *field.ID)
+	resp, err := c.client.R().SetBody(field).SetResult(field).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating metadata field: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteMetadataField(fieldID int64) error {
+	url := fmt.Sprintf("%s%d/", c.metadataFieldsURL(), fieldID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting metadata field: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) GetMetadataModelField(organisationID, modelFieldID int64) (*MetadataModelField, error) {
+	url := fmt.Sprintf("%s%d/", c.metadataModelFieldsURL(organisationID), modelFieldID)
+	modelField := MetadataModelField{}
+	resp, err := c.client.R().SetResult(&modelField).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "metadata model field", id: strconv.FormatInt(modelFieldID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching metadata model field: %s", resp)
+	}
+	return &modelField, nil
+}
+
+func (c *Client) GetMetadataModelFields(organisationID int64) ([]MetadataModelField, error) {
+	var modelFields []MetadataModelField
+	resp, err := c.getList(c.client.R(), c.metadataModelFieldsURL(organisationID), &modelFields)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching metadata model fields: %s", resp)
+	}
+	return modelFields, nil
+}
+
+func (c *Client) CreateMetadataModelField(organisationID int64, modelField *MetadataModelField) error {
+	resp, err := c.client.R().
+		SetBody(modelField).
+		SetResult(modelField).
+		Post(c.metadataModelFieldsURL(organisationID))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating metadata model field: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateMetadataModelField(organisationID int64, modelField *MetadataModelField) error {
+	url := fmt.Sprintf("%s%d/", c.metadataModelFieldsURL(organisationID), *modelField.ID)
+	resp, err := c.client.R().SetBody(modelField).SetResult(modelField).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating metadata model field: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteMetadataModelField(organisationID, modelFieldID int64) error {
+	url := fmt.Sprintf("%s%d/", c.metadataModelFieldsURL(organisationID), modelFieldID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting metadata model field: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) getContentTypes(url string) ([]ContentType, error) {
+	var contentTypes []ContentType
+	resp, err := c.getList(c.client.R(), url, &contentTypes)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching content types: %s", resp)
+	}
+	return contentTypes, nil
+}
+
+// GetMetadataContentTypes returns the content types metadata fields can be attached to
+func (c *Client) GetMetadataContentTypes(organisationID int64) ([]ContentType, error) {
+	return c.getContentTypes(c.metadataModelFieldsURL(organisationID) + "supported-content-types/")
+}
+
+// GetMetadataRequirementContentTypes returns the content types(e.g: `project`) a metadata field attached to
+// the given model can be required for
+func (c *Client) GetMetadataRequirementContentTypes(organisationID int64, model string) ([]ContentType, error) {
+	return c.getContentTypes(c.metadataModelFieldsURL(organisationID) + "supported-required-for-models/?model_name=" + model)
+}
+
+// GetContentTypeMetadataFields returns the metadata fields of the organisation and whether they are
+// attached to the given model(i.e: `feature`, `segment` or `environment`)
+func (c *Client) GetContentTypeMetadataFields(organisationID int64, model string) ([]ContentTypeMetadataField, error) {
+	contentTypes, err := c.GetMetadataContentTypes(organisationID)
+	if err != nil {
+		return nil, err
+	}
+	var contentTypeID *int64
+	for _, contentType := range contentTypes {
+		if contentType.Model == model {
+			contentTypeID = &contentType.ID
+		}
+	}
+	if contentTypeID == nil {
+		return nil, NotFoundError{kind: "metadata content type", id: model}
+	}
+	fields, err := c.GetMetadataFields(organisationID)
+	if err != nil {
+		return nil, err
+	}
+	modelFields, err := c.GetMetadataModelFields(organisationID)
+	if err != nil {
+		return nil, err
+	}
+	contentTypeFields := []ContentTypeMetadataField{}
+	for _, field := range fields {
+		contentTypeField := ContentTypeMetadataField{Field: field}
+		for _, modelField := range modelFields {
+			if modelField.Field == *field.ID && modelField.ContentType == *contentTypeID {
+				contentTypeField.ModelFieldID = modelField.ID
+			}
+		}
+		contentTypeFields = append(contentTypeFields, contentTypeField)
+	}
+	return contentTypeFields, nil
+}
+
+// saveWithMetadata creates(POST) or updates(PUT) an object of the api client along with its metadata,
+// which is not part of the models of the api client. The response is decoded into object
+func (c *Client) saveWithMetadata(method, url, kind string, object interface{}, metadata []Metadata) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	body := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &body)
+	if err != nil {
+		return err
+	}
+	body["metadata"], err = json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.R().SetBody(body).SetResult(object).Execute(method, url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error saving %s: %s", kind, resp)
+	}
+	return nil
+}
+
+func (c *Client) getMetadata(url, kind string) ([]Metadata, error) {
+	result := struct {
+		Metadata []Metadata `json:"metadata"`
+	}{}
+	resp, err := c.client.R().SetResult(&result).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching %s metadata: %s", kind, resp)
+	}
+	return result.Metadata, nil
+}
+
+func (c *Client) CreateFeatureWithMetadata(feature *flagsmithapi.Feature, metadata []Metadata) error {
+	if feature.ProjectID == nil {
+		project, err := c.GetProject(feature.ProjectUUID)
+		if err != nil {
+			return err
+		}
+		feature.ProjectID = &project.ID
+	}
+	url := fmt.Sprintf("%s/projects/%d/features/", c.baseURL, *feature.ProjectID)
+	return c.saveWithMetadata("POST", url, "feature", feature, metadata)
+}
+
+func (c *Client) UpdateFeatureWithMetadata(feature *flagsmithapi.Feature, metadata []Metadata) error {
+	url := fmt.Sprintf("%s/projects/%d/features/%d/", c.baseURL, *feature.ProjectID, *feature.ID)
+	return c.saveWithMetadata("PUT", url, "feature", feature, metadata)
+}
+
+func (c *Client) GetFeatureMetadata(projectID, featureID int64) ([]Metadata, error) {
+	return c.getMetadata(fmt.Sprintf("%s/projects/%d/features/%d/", c.baseURL, projectID, featureID), "feature")
+}
+
+func (c *Client) CreateSegmentWithMetadata(segment *flagsmithapi.Segment, metadata []Metadata) error {
+	if segment.ProjectID == nil {
+		project, err := c.GetProject(segment.ProjectUUID)
+		if err != nil {
+			return err
+		}
+		segment.ProjectID = &project.ID
+	}
+	url := fmt.Sprintf("%s/projects/%d/segments/", c.baseURL, *segment.ProjectID)
+	return c.saveWithMetadata("POST", url, "segment", segment, metadata)
+}
+
+func (c *Client) UpdateSegmentWithMetadata(segment *flagsmithapi.Segment, metadata []Metadata) error {
+	if segment.ProjectID == nil {
+		project, err := c.GetProject(segment.ProjectUUID)
+		if err != nil {
+			return err
+		}
+		segment.ProjectID = &project.ID
+	}
+	url := fmt.Sprintf("%s/projects/%d/segments/%d/", c.baseURL, *segment.ProjectID, *segment.ID)
+	return c.saveWithMetadata("PUT", url, "segment", segment, metadata)
+}
+
+func (c *Client) GetSegmentMetadata(projectID, segmentID int64) ([]Metadata, error) {
+	return c.getMetadata(fmt.Sprintf("%s/projects/%d/segments/%d/", c.baseURL, projectID, segmentID), "segment")
+}
+
+func (c *Client) CreateEnvironmentWithMetadata(environment *flagsmithapi.Environment, metadata []Metadata) error {
+	return c.saveWithMetadata("POST", fmt.Sprintf("%s/environments/", c.baseURL), "environment", environment, metadata)
+}
+
+func (c *Client) UpdateEnvironmentWithMetadata(environment *flagsmithapi.Environment, metadata []Metadata) error {
+	url := fmt.Sprintf("%s/environments/%s/", c.baseURL, environment.APIKey)
+	return c.saveWithMetadata("PUT", url, "environment", environment, metadata)
+}
+
+func (c *Client) GetEnvironmentMetadata(environmentKey string) ([]Metadata, error) {
+	return c.getMetadata(fmt.Sprintf("%s/environments/%s/", c.baseURL, environmentKey), "environment")
+}
@@ -0,0 +1,250 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Organisation is an organisation with the settings that can be managed, unlike `flagsmithapi.Organisation`
+// it includes the webhook notification email
+type Organisation struct {
+	ID                           int64   `json:"id,omitempty"`
+	UUID                         string  `json:"uuid,omitempty"`
+	Name                         string  `json:"name"`
+	Force2FA                     bool    `json:"force_2fa"`
+	PersistTraitData             bool    `json:"persist_trait_data"`
+	RestrictProjectCreateToAdmin bool    `json:"restrict_project_create_to_admin"`
+	WebhookNotificationEmail     *string `json:"webhook_notification_email"`
+}
+
+func (c *Client) GetOrganisation(organisationUUID string) (*Organisation, error) {
+	url := fmt.Sprintf("%s/organisations/get-by-uuid/%s/", c.baseURL, organisationUUID)
+	organisation := Organisation{}
+	resp, err := c.client.R().SetResult(&organisation).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "organisation", id: organisationUUID}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching organisation: %s", resp)
+	}
+	return &organisation, nil
+}
+
+func (c *Client) UpdateOrganisation(organisation *Organisation) error {
+	url := fmt.Sprintf("%s/organisations/%d/", c.baseURL, organisation.ID)
+	resp, err := c.client.R().SetBody(organisation).SetResult(organisation).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return NotFoundError{kind: "organisation", id: strconv.FormatInt(organisation.ID, 10)}
+		}
+		return fmt.Errorf("flagsmith: Error updating organisation: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteOrganisation(organisationID int64) error {
+	url := fmt.Sprintf("%s/organisations/%d/", c.baseURL, organisationID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting organisation: %s", resp)
+	}
+	return nil
+}
+
+var organisationRoles = []string{"ADMIN", "USER"}
+
+// OrganisationInvite is a pending invitation to join an organisation, it is deleted once accepted
+type OrganisationInvite struct {
+	ID               *int64  `json:"id,omitempty"`
+	Email            string  `json:"email"`
+	Role             string  `json:"role"`
+	PermissionGroups []int64 `json:"permission_groups"`
+	OrganisationID   int64   `json:"-"`
+}
+
+func (c *Client) organisationInvitesURL(organisationID int64) string {
+	return fmt.Sprintf("%s/organisations/%d/invites/", c.baseURL, organisationID)
+}
+
+func (c *Client) GetOrganisationInvites(organisationID int64) ([]OrganisationInvite, error) {
+	var invites []OrganisationInvite
+	resp, err := c.getList(c.client.R(), c.organisationInvitesURL(organisationID), &invites)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching organisation invites: %s", resp)
+	}
+	for i := range invites {
+		invites[i].OrganisationID = organisationID
+	}
+	return invites, nil
+}
+
+func (c *Client) GetOrganisationInvite(organisationID, inviteID int64) (*OrganisationInvite, error) {
+	url := fmt.Sprintf("%s%d/", c.organisationInvitesURL(organisationID), inviteID)
+	invite := OrganisationInvite{}
+	resp, err := c.client.R().SetResult(&invite).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "organisation invite", id: strconv.FormatInt(inviteID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching organisation invite: %s", resp)
+	}
+	invite.OrganisationID = organisationID
+	return &invite, nil
+}
+
+// CreateOrganisationInvite invites the email of the invite to the organisation, the invite endpoint does
+// not return the created invite so its ID must be looked up afterwards, see FindOrganisationInvite
+func (c *Client) CreateOrganisationInvite(invite *OrganisationInvite) error {
+	url := fmt.Sprintf("%s/organisations/%d/invite/", c.baseURL, invite.OrganisationID)
+	body := struct {
+		Invites []*OrganisationInvite `json:"invites"`
+	}{
+		Invites: []*OrganisationInvite{invite},
+	}
+	resp, err := c.client.R().SetBody(body).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating organisation invite: %s", resp)
+	}
+	return nil
+}
+
+// FindOrganisationInvite loads the newest(i.e: the highest ID) invite for the email of the invite into it
+func (c *Client) FindOrganisationInvite(invite *OrganisationInvite) error {
+	invites, err := c.GetOrganisationInvites(invite.OrganisationID)
+	if err != nil {
+		return err
+	}
+	var newest *OrganisationInvite
+	for i := range invites {
+		if invites[i].ID == nil || !strings.EqualFold(invites[i].Email, invite.Email) {
+			continue
+		}
+		if newest == nil || *invites[i].ID > *newest.ID {
+			newest = &invites[i]
+		}
+	}
+	if newest == nil {
+		return NotFoundError{kind: "organisation invite", id: invite.Email}
+	}
+	*invite = *newest
+	return nil
+}
+
+func (c *Client) DeleteOrganisationInvite(organisationID, inviteID int64) error {
+	url := fmt.Sprintf("%s%d/", c.organisationInvitesURL(organisationID), inviteID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return NotFoundError{kind: "organisation invite", id: strconv.FormatInt(inviteID, 10)}
+		}
+		return fmt.Errorf("flagsmith: Error deleting organisation invite: %s", resp)
+	}
+	return nil
+}
+
+// OrganisationUser is a member of an organisation
+type OrganisationUser struct {
+	ID        int64  `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Role      string `json:"role"`
+}
+
+func (c *Client) GetOrganisationUsers(organisationID int64) ([]OrganisationUser, error) {
+	url := fmt.Sprintf("%s/organisations/%d/users/", c.baseURL, organisationID)
+	var users []OrganisationUser
+	resp, err := c.getList(c.client.R(), url, &users)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching organisation users: %s", resp)
+	}
+	return users, nil
+}
+
+// GetOrganisationUser returns the member of the organisation with the given ID
+func (c *Client) GetOrganisationUser(organisationID, userID int64) (*OrganisationUser, error) {
+	users, err := c.GetOrganisationUsers(organisationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.ID == userID {
+			return &user, nil
+		}
+	}
+	return nil, NotFoundError{kind: "organisation user", id: strconv.FormatInt(userID, 10)}
+}
+
+// GetOrganisationUserByEmail returns the member of the organisation with the given email
+func (c *Client) GetOrganisationUserByEmail(organisationID int64, email string) (*OrganisationUser, error) {
+	users, err := c.GetOrganisationUsers(organisationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return &user, nil
+		}
+	}
+	return nil, NotFoundError{kind: "organisation user", id: email}
+}
+
+func (c *Client) UpdateOrganisationUserRole(organisationID, userID int64, role string) error {
+	url := fmt.Sprintf("%s/organisations/%d/users/%d/update-role/", c.baseURL, organisationID, userID)
+	body := struct {
+		Role string `json:"role"`
+	}{
+		Role: role,
+	}
+	resp, err := c.client.R().SetBody(body).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating organisation user role: %s", resp)
+	}
+	return nil
+}
+
+// RemoveOrganisationUser removes the user from the organisation, the user account itself is not deleted
+func (c *Client) RemoveOrganisationUser(organisationID, userID int64) error {
+	url := fmt.Sprintf("%s/organisations/%d/remove-users/", c.baseURL, organisationID)
+	body := []struct {
+		ID int64 `json:"id"`
+	}{
+		{ID: userID},
+	}
+	resp, err := c.client.R().SetBody(body).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error removing organisation user: %s", resp)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package flagsmith
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Permission is a permission key advertised by the `permissions` endpoint
+// of a given scope(e.g: `projects`, `environments`)
+type Permission struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+// ObjectPermission represents the permissions granted to a user or to a group
+// on a single object(i.e: a project or an environment)
+type ObjectPermission struct {
+	ID          *int64   `json:"id,omitempty"`
+	User        *int64   `json:"user,omitempty"`
+	Group       *int64   `json:"group,omitempty"`
+	Admin       bool     `json:"admin"`
+	Permissions []string `json:"permissions"`
+}
+
+func (p *ObjectPermission) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		ID          *int64          `json:"id"`
+		User        json.RawMessage `json:"user"`
+		Group       json.RawMessage `json:"group"`
+		Admin       bool            `json:"admin"`
+		Permissions []string        `json:"permissions"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	p.ID = obj.ID
+	p.Admin = obj.Admin
+	p.Permissions = obj.Permissions
+	if p.Permissions == nil {
+		p.Permissions = []string{}
+	}
+	p.User, err = decodeObjectID(obj.User)
+	if err != nil {
+		return err
+	}
+	p.Group, err = decodeObjectID(obj.Group)
+	return err
+}
+
+// decodeObjectID decodes a related object that can either be
+// returned as a plain ID or as a nested object(with an `id`)
+func decodeObjectID(data json.RawMessage) (*int64, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var id int64
+	if err := json.Unmarshal(data, &id); err == nil {
+		return &id, nil
+	}
+	var obj struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return &obj.ID, nil
+}
+
+func (c *Client) GetAvailablePermissions(scope string) ([]Permission, error) {
+	url := fmt.Sprintf("%s/%s/permissions/", c.baseURL, scope)
+	permissions := []Permission{}
+	resp, err := c.client.R().SetResult(&permissions).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching %s permissions: %s", scope, resp)
+	}
+	return permissions, nil
+}
+
+func permissionsEndpoint(group bool) string {
+	if group {
+		return "user-group-permissions"
+	}
+	return "user-permissions"
+}
+
+func (c *Client) getObjectPermission(objectURL string, permissionID int64, group bool) (*ObjectPermission, error) {
+	url := fmt.Sprintf("%s/%s/%d/", objectURL, permissionsEndpoint(group), permissionID)
+	permission := ObjectPermission{}
+	resp, err := c.client.R().SetResult(&permission).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "permission", id: strconv.FormatInt(permissionID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching permission: %s", resp)
+	}
+	return &permission, nil
+}
+
+// findObjectPermission returns the permissions of the given user or group(only one of them must be set)
+func (c *Client) findObjectPermission(objectURL string, userID, groupID *int64) (*ObjectPermission, error) {
+	url := fmt.Sprintf("%s/%s/", objectURL, permissionsEndpoint(groupID != nil))
+	var permissions []ObjectPermission
+	resp, err := c.getList(c.client.R(), url, &permissions)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmith: Error fetching permissions: %s", resp)
+	}
+	for _, permission := range permissions {
+		if userID != nil && permission.User != nil && *permission.User == *userID {
+			return &permission, nil
+		}
+		if groupID != nil && permission.Group != nil && *permission.Group == *groupID {
+			return &permission, nil
+		}
+	}
+	if groupID != nil {
+		return nil, NotFoundError{kind: "permission of group", id: strconv.FormatInt(*groupID, 10)}
+	}
+	return nil, NotFoundError{kind: "permission of user", id: strconv.FormatInt(*userID, 10)}
+}
+
+func (c *Client) createObjectPermission(objectURL string, permission *ObjectPermission) error {
+	url := fmt.Sprintf("%s/%s/", objectURL, permissionsEndpoint(permission.Group != nil))
+	resp, err := c.client.R().SetBody(permission).SetResult(permission).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating permission: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) updateObjectPermission(objectURL string, permission *ObjectPermission) error {
+	url := fmt.Sprintf("%s/%s/%d/", objectURL, permissionsEndpoint(permission.Group != nil), *permission.ID)
+	resp, err := c.client.R().SetBody(permission).SetResult(permission).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating permission: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) deleteObjectPermission(objectURL string, permissionID int64, group bool) error {
+	url := fmt.Sprintf("%s/%s/%d/", objectURL, permissionsEndpoint(group), permissionID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting permission: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) projectURL(projectID int64) string {
+	return fmt.Sprintf("%s/projects/%d", c.baseURL, projectID)
+}
+
+func (c *Client) GetProjectPermission(projectID, permissionID int64, group bool) (*ObjectPermission, error) {
+	return c.getObjectPermission(c.projectURL(projectID), permissionID, group)
+}
+
+func (c *Client) FindProjectPermission(projectID int64, userID, groupID *int64) (*ObjectPermission, error) {
+	return c.findObjectPermission(c.projectURL(projectID), userID, groupID)
+}
+
+func (c *Client) CreateProjectPermission(projectID int64, permission *ObjectPermission) error {
+	return c.createObjectPermission(c.projectURL(projectID), permission)
+}
+
+func (c *Client) UpdateProjectPermission(projectID int64, permission *ObjectPermission) error {
+	return c.updateObjectPermission(c.projectURL(projectID), permission)
+}
+
+func (c *Client) DeleteProjectPermission(projectID, permissionID int64, group bool) error {
+	return c.deleteObjectPermission(c.projectURL(projectID), permissionID, group)
+}
+
+func (c *Client) environmentURL(environmentKey string) string {
+	return fmt.Sprintf("%s/environments/%s", c.baseURL, environmentKey)
+}
+
+func (c *Client) GetEnvironmentPermission(environmentKey string, permissionID int64, group bool) (*ObjectPermission, error) {
+	return c.getObjectPermission(c.environmentURL(environmentKey), permissionID, group)
+}
+
+func (c *Client) FindEnvironmentPermission(environmentKey string, userID, groupID *int64) (*ObjectPermission, error) {
+	return c.findObjectPermission(c.environmentURL(environmentKey), userID, groupID)
+}
+
+func (c *Client) CreateEnvironmentPermission(environmentKey string, permission *ObjectPermission) error {
+	return c.createObjectPermission(c.environmentURL(environmentKey), permission)
+}
+
+func (c *Client) UpdateEnvironmentPermission(environmentKey string, permission *ObjectPermission) error {
+	return c.updateObjectPermission(c.environmentURL(environmentKey), permission)
+}
+
+func (c *Client) DeleteEnvironmentPermission(environmentKey string, permissionID int64, group bool) error {
+	return c.deleteObjectPermission(c.environmentURL(environmentKey), permissionID, group)
+}
@@ -0,0 +1,84 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// projectIntegrationTypes are the APM integrations that can be configured for a project
+var projectIntegrationTypes = []string{"datadog", "new_relic", "dynatrace", "grafana"}
+
+// ProjectIntegration is the union of the attributes of the project integrations,
+// the type specific attributes are only sent if set
+type ProjectIntegration struct {
+	ID      *int64 `json:"id,omitempty"`
+	APIKey  string `json:"api_key"`
+	BaseURL string `json:"base_url"`
+	// Datadog
+	UseCustomSource *bool `json:"use_custom_source,omitempty"`
+	// New Relic
+	AppID *string `json:"app_id,omitempty"`
+	// Dynatrace
+	EntitySelector *string `json:"entity_selector,omitempty"`
+}
+
+// projectIntegrationsURL returns the URL of the integration, the API uses dashes
+// in the path, e.g: `new_relic` is served at `new-relic`
+func (c *Client) projectIntegrationsURL(projectID int64, integrationType string) string {
+	return fmt.Sprintf("%s/projects/%d/integrations/%s/", c.baseURL, projectID, strings.ReplaceAll(integrationType, "_", "-"))
+}
+
+func (c *Client) GetProjectIntegration(projectID int64, integrationType string, integrationID int64) (*ProjectIntegration, error) {
+	url := fmt.Sprintf("%s%d/", c.projectIntegrationsURL(projectID, integrationType), integrationID)
+	integration := ProjectIntegration{}
+	resp, err := c.client.R().SetResult(&integration).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: integrationType + " integration", id: strconv.FormatInt(integrationID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching %s integration: %s", integrationType, resp)
+	}
+	return &integration, nil
+}
+
+func (c *Client) CreateProjectIntegration(projectID int64, integrationType string, integration *ProjectIntegration) error {
+	resp, err := c.client.R().
+		SetBody(integration).
+		SetResult(integration).
+		Post(c.projectIntegrationsURL(projectID, integrationType))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating %s integration: %s", integrationType, resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateProjectIntegration(projectID int64, integrationType string, integration *ProjectIntegration) error {
+	url := fmt.Sprintf("%s%d/", c.projectIntegrationsURL(projectID, integrationType), *integration.ID)
+	resp, err := c.client.R().SetBody(integration).SetResult(integration).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating %s integration: %s", integrationType, resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteProjectIntegration(projectID int64, integrationType string, integrationID int64) error {
+	url := fmt.Sprintf("%s%d/", c.projectIntegrationsURL(projectID, integrationType), integrationID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting %s integration: %s", integrationType, resp)
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package flagsmith
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type Role struct {
+	ID             *int64 `json:"id,omitempty"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	OrganisationID int64  `json:"organisation"`
+}
+
+// RolePermission represents the permissions granted to a role on the organisation,
+// a project or an environment(in which case `Project` or `Environment` is set)
+type RolePermission struct {
+	ID          *int64   `json:"id,omitempty"`
+	Role        int64    `json:"role"`
+	Project     *int64   `json:"project,omitempty"`
+	Environment *int64   `json:"environment,omitempty"`
+	Admin       *bool    `json:"admin,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func (p *RolePermission) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		ID          *int64          `json:"id"`
+		Role        int64           `json:"role"`
+		Project     json.RawMessage `json:"project"`
+		Environment json.RawMessage `json:"environment"`
+		Admin       *bool           `json:"admin"`
+		Permissions []string        `json:"permissions"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	p.ID = obj.ID
+	// role is not part of every response(i.e: it's implied by the url)
+	if obj.Role != 0 {
+		p.Role = obj.Role
+	}
+	p.Admin = obj.Admin
+	p.Permissions = obj.Permissions
+	if p.Permissions == nil {
+		p.Permissions = []string{}
+	}
+	p.Project, err = decodeObjectID(obj.Project)
+	if err != nil {
+		return err
+	}
+	p.Environment, err = decodeObjectID(obj.Environment)
+	return err
+}
+
+// RoleAssignment binds a role to a user, a group or a master API key(only one of them is set)
+type RoleAssignment struct {
+	ID           *int64  `json:"id,omitempty"`
+	Role         int64   `json:"role"`
+	User         *int64  `json:"user,omitempty"`
+	Group        *int64  `json:"group,omitempty"`
+	MasterAPIKey *string `json:"master_api_key,omitempty"`
+}
+
+func (a *RoleAssignment) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		ID           *int64          `json:"id"`
+		Role         int64           `json:"role"`
+		User         json.RawMessage `json:"user"`
+		Group        json.RawMessage `json:"group"`
+		MasterAPIKey json.RawMessage `json:"master_api_key"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	a.ID = obj.ID
+	// role is not part of every response(i.e: it's implied by the url)
+	if obj.Role != 0 {
+		a.Role = obj.Role
+	}
+	a.User, err = decodeObjectID(obj.User)
+	if err != nil {
+		return err
+	}
+	a.Group, err = decodeObjectID(obj.Group)
+	if err != nil {
+		return err
+	}
+	if len(obj.MasterAPIKey) > 0 && string(obj.MasterAPIKey) != "null" {
+		var masterAPIKey string
+		if err := json.Unmarshal(obj.MasterAPIKey, &masterAPIKey); err != nil {
+			// nested master api key object
+			var key struct {
+				ID string `json:"id"`
+			}
+			if err := json.Unmarshal(obj.MasterAPIKey, &key); err != nil {
+				return err
+			}
+			masterAPIKey = key.ID
+		}
+		a.MasterAPIKey = &masterAPIKey
+	}
+	return nil
+}
+
+func (c *Client) rolesURL(organisationID int64) string {
+	return fmt.Sprintf("%s/organisations/%d/roles/", c.baseURL, organisationID)
+}
+
+func (c *Client) GetRole(organisationID, roleID int64) (*Role, error) {
+	url := fmt.Sprintf("%s%d/", c.rolesURL(organisationID), roleID)
+	role := Role{}
+	resp, err := c.client.R().SetResult(&role).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "role", id: strconv.FormatInt(roleID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching role: %s", resp)
+	}
+	return &role, nil
+}
+
+func (c *Client) CreateRole(role *Role) error {
+	resp, err := c.client.R().SetBody(role).SetResult(role).Post(c.rolesURL(role.OrganisationID))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating role: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateRole(role *Role) error {
+	url := fmt.Sprintf("%s%d/", c.rolesURL(role.OrganisationID), *role.ID)
+	resp, err := c.client.R().SetBody(role).SetResult(role).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating role: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteRole(organisationID, roleID int64) error {
+	url := fmt.Sprintf("%s%d/", c.rolesURL(organisationID), roleID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting role: %s", resp)
+	}
+	return nil
+}
+
+// Role permissions are managed through a different endpoint for each scope
+const (
+	RoleOrganisationPermissions = "organisation-permissions"
+	RoleProjectPermissions      = "projects-permissions"
+	RoleEnvironmentPermissions  = "environments-permissions"
+)
+
+func (c *Client) rolePermissionsURL(organisationID, roleID int64, scope string) string {
+	return fmt.Sprintf("%s%d/%s/", c.rolesURL(organisationID), roleID, scope)
+}
+
+func (c *Client) GetRolePermission(organisationID, roleID int64, scope string, permissionID int64) (*RolePermission, error) {
+	url := fmt.Sprintf("%s%d/", c.rolePermissionsURL(organisationID, roleID, scope), permissionID)
+	permission := RolePermission{}
+	resp, err := c.client.R().SetResult(&permission).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "role permission", id: strconv.FormatInt(permissionID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching role permission: %s", resp)
+	}
+	return &permission, nil
+}
+
+// FindRolePermission returns the permissions of the role on the given object(project or environment ID).
+// objectID is ignored for the organisation scope
+func (c *Client) FindRolePermission(organisationID, roleID int64, scope string, objectID int64) (*RolePermission, error) {
+	var permissions []RolePermission
+	resp, err := c.getList(c.client.R(), c.rolePermissionsURL(organisationID, roleID, scope), &permissions)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "role", id: strconv.FormatInt(roleID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching role permissions: %s", resp)
+	}
+	for _, permission := range permissions {
+		switch {
+		case scope == RoleOrganisationPermissions:
+			return &permission, nil
+		case permission.Project != nil && *permission.Project == objectID:
+			return &permission, nil
+		case permission.Environment != nil && *permission.Environment == objectID:
+			return &permission, nil
+		}
+	}
+	return nil, NotFoundError{kind: "role permission", id: strconv.FormatInt(objectID, 10)}
+}
+
+func (c *Client) CreateRolePermission(organisationID int64, scope string, permission *RolePermission) error {
+	url := c.rolePermissionsURL(organisationID, permission.Role, scope)
+	resp, err := c.client.R().SetBody(permission).SetResult(permission).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating role permission: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateRolePermission(organisationID int64, scope string, permission *RolePermission) error {
+	url := fmt.Sprintf("%s%d/", c.rolePermissionsURL(organisationID, permission.Role, scope), *permission.ID)
+	resp, err := c.client.R().SetBody(permission).SetResult(permission).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating role permission: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteRolePermission(organisationID, roleID int64, scope string, permissionID int64) error {
+	url := fmt.Sprintf("%s%d/", c.rolePermissionsURL(organisationID, roleID, scope), permissionID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting role permission: %s", resp)
+	}
+	return nil
+}
+
+func roleAssignmentsEndpoint(assignment *RoleAssignment) string {
+	switch {
+	case assignment.Group != nil:
+		return "groups"
+	case assignment.MasterAPIKey != nil:
+		return "master-api-keys"
+	default:
+		return "users"
+	}
+}
+
+func (c *Client) roleAssignmentsURL(organisationID int64, assignment *RoleAssignment) string {
+	return fmt.Sprintf("%s%d/%s/", c.rolesURL(organisationID), assignment.Role, roleAssignmentsEndpoint(assignment))
+}
+
+// GetRoleAssignment refreshes the given assignment. If the ID of the assignment is not set
+// the assignment is looked up by user, group or master API key
+func (c *Client) GetRoleAssignment(organisationID int64, assignment *RoleAssignment) (*RoleAssignment, error) {
+	var assignments []RoleAssignment
+	resp, err := c.getList(c.client.R(), c.roleAssignmentsURL(organisationID, assignment), &assignments)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "role", id: strconv.FormatInt(assignment.Role, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching role assignments: %s", resp)
+	}
+	for _, existing := range assignments {
+		if assignment.ID != nil {
+			if existing.ID != nil && *existing.ID == *assignment.ID {
+				return &existing, nil
+			}
+			continue
+		}
+		if (assignment.User != nil && existing.User != nil && *assignment.User == *existing.User) ||
+			(assignment.Group != nil && existing.Group != nil && *assignment.Group == *existing.Group) ||
+			(assignment.MasterAPIKey != nil && existing.MasterAPIKey != nil && *assignment.MasterAPIKey == *existing.MasterAPIKey) {
+			return &existing, nil
+		}
+	}
+	return nil, NotFoundError{kind: "role assignment", id: strconv.FormatInt(assignment.Role, 10)}
+}
+
+func (c *Client) CreateRoleAssignment(organisationID int64, assignment *RoleAssignment) error {
+	url := c.roleAssignmentsURL(organisationID, assignment)
+	resp, err := c.client.R().SetBody(assignment).SetResult(assignment).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating role assignment: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteRoleAssignment(organisationID int64, assignment *RoleAssignment) error {
+	url := fmt.Sprintf("%s%d/", c.roleAssignmentsURL(organisationID, assignment), *assignment.ID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting role assignment: %s", resp)
+	}
+	return nil
+}
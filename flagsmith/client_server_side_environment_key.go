@@ -0,0 +1,20 @@
+package flagsmith
+
+import (
+	"strconv"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+func (c *Client) GetServerSideEnvKey(environmentKey string, keyID int64) (*flagsmithapi.ServerSideEnvKey, error) {
+	keys, err := c.GetServerSideEnvKeys(environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.ID == keyID {
+			return &key, nil
+		}
+	}
+	return nil, NotFoundError{kind: "server side environment key", id: strconv.FormatInt(keyID, 10)}
+}
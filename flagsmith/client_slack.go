@@ -0,0 +1,194 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SlackProjectConfiguration binds a project to a Slack workspace. `APIToken` is
+// the bot token of the workspace and is not returned by the API
+type SlackProjectConfiguration struct {
+	ID       *int64 `json:"id,omitempty"`
+	APIToken string `json:"api_token,omitempty"`
+}
+
+// SlackEnvironmentConfiguration is the channel the changes of an environment are posted to
+type SlackEnvironmentConfiguration struct {
+	ID        *int64 `json:"id,omitempty"`
+	ChannelID string `json:"channel_id"`
+	Enabled   bool   `json:"enabled"`
+}
+
+type SlackChannel struct {
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+}
+
+func (c *Client) projectSlackURL(projectID int64) string {
+	return fmt.Sprintf("%s/projects/%d/integrations/slack/", c.baseURL, projectID)
+}
+
+func (c *Client) environmentSlackURL(environmentKey string) string {
+	return fmt.Sprintf("%s/environments/%s/integrations/slack/", c.baseURL, environmentKey)
+}
+
+func (c *Client) GetProjectSlackConfiguration(projectID, configurationID int64) (*SlackProjectConfiguration, error) {
+	url := fmt.Sprintf("%s%d/", c.projectSlackURL(projectID), configurationID)
+	configuration := SlackProjectConfiguration{}
+	resp, err := c.client.R().SetResult(&configuration).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "slack integration", id: strconv.FormatInt(configurationID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching slack integration: %s", resp)
+	}
+	return &configuration, nil
+}
+
+func (c *Client) CreateProjectSlackConfiguration(projectID int64, configuration *SlackProjectConfiguration) error {
+	resp, err := c.client.R().SetBody(configuration).SetResult(configuration).Post(c.projectSlackURL(projectID))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating slack integration: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateProjectSlackConfiguration(projectID int64, configuration *SlackProjectConfiguration) error {
+	url := fmt.Sprintf("%s%d/", c.projectSlackURL(projectID), *configuration.ID)
+	resp, err := c.client.R().SetBody(configuration).SetResult(configuration).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating slack integration: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteProjectSlackConfiguration(projectID, configurationID int64) error {
+	url := fmt.Sprintf("%s%d/", c.projectSlackURL(projectID), configurationID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting slack integration: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) GetEnvironmentSlackConfiguration(environmentKey string, configurationID int64) (*SlackEnvironmentConfiguration, error) {
+	url := fmt.Sprintf("%s%d/", c.environmentSlackURL(environmentKey), configurationID)
+	configuration := SlackEnvironmentConfiguration{}
+	resp, err := c.client.R().SetResult(&configuration).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "slack channel", id: strconv.FormatInt(configurationID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching slack channel: %s", resp)
+	}
+	return &configuration, nil
+}
+
+func (c *Client) CreateEnvironmentSlackConfiguration(environmentKey string, configuration *SlackEnvironmentConfiguration) error {
+	resp, err := c.client.R().SetBody(configuration).SetResult(configuration).Post(c.environmentSlackURL(environmentKey))
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating slack channel: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateEnvironmentSlackConfiguration(environmentKey string, configuration *SlackEnvironmentConfiguration) error {
+	url := fmt.Sprintf("%s%d/", c.environmentSlackURL(environmentKey), *configuration.ID)
+	resp, err := c.client.R().SetBody(configuration).SetResult(configuration).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating slack channel: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteEnvironmentSlackConfiguration(environmentKey string, configurationID int64) error {
+	url := fmt.Sprintf("%s%d/", c.environmentSlackURL(environmentKey), configurationID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting slack channel: %s", resp)
+	}
+	return nil
+}
+
+// findSlackChannel pages through the channels of the workspace bound to the project
+// of the environment and returns the first one matching
+func (c *Client) findSlackChannel(environmentKey string, match func(SlackChannel) bool) (*SlackChannel, error) {
+	url := fmt.Sprintf("%s/environments/%s/integrations/slack-channels/", c.baseURL, environmentKey)
+	cursor := ""
+	for {
+		result := struct {
+			Channels []SlackChannel `json:"channels"`
+			Cursor   string         `json:"cursor"`
+		}{}
+		req := c.client.R().SetQueryParam("limit", "1000").SetResult(&result)
+		if cursor != "" {
+			req.SetQueryParam("cursor", cursor)
+		}
+		resp, err := req.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.IsSuccess() {
+			return nil, fmt.Errorf("flagsmith: Error fetching slack channels: %s", resp)
+		}
+		for _, channel := range result.Channels {
+			if match(channel) {
+				return &channel, nil
+			}
+		}
+		if result.Cursor == "" {
+			return nil, nil
+		}
+		cursor = result.Cursor
+	}
+}
+
+func (c *Client) GetSlackChannelByName(environmentKey, channelName string) (*SlackChannel, error) {
+	channel, err := c.findSlackChannel(environmentKey, func(channel SlackChannel) bool {
+		return channel.ChannelName == channelName
+	})
+	if err != nil {
+		return nil, err
+	}
+	if channel == nil {
+		return nil, NotFoundError{kind: "slack channel", id: channelName}
+	}
+	return channel, nil
+}
+
+func (c *Client) GetSlackChannelByID(environmentKey, channelID string) (*SlackChannel, error) {
+	channel, err := c.findSlackChannel(environmentKey, func(channel SlackChannel) bool {
+		return channel.ChannelID == channelID
+	})
+	if err != nil {
+		return nil, err
+	}
+	if channel == nil {
+		return nil, NotFoundError{kind: "slack channel", id: channelID}
+	}
+	return channel, nil
+}
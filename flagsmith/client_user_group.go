@@ -0,0 +1,131 @@
+package flagsmith
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type UserGroup struct {
+	ID         *int64  `json:"id,omitempty"`
+	Name       string  `json:"name"`
+	ExternalID *string `json:"external_id"`
+	IsDefault  bool    `json:"is_default"`
+
+	// Members are managed using the add-users/remove-users endpoints
+	Users          *[]int64 `json:"-"`
+	OrganisationID int64    `json:"-"`
+}
+
+func (g *UserGroup) UnmarshalJSON(data []byte) error {
+	type user struct {
+		ID int64 `json:"id"`
+	}
+	var obj struct {
+		ID         *int64  `json:"id"`
+		Name       string  `json:"name"`
+		ExternalID *string `json:"external_id"`
+		IsDefault  bool    `json:"is_default"`
+		Users      []user  `json:"users"`
+	}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return err
+	}
+	g.ID = obj.ID
+	g.Name = obj.Name
+	g.ExternalID = obj.ExternalID
+	g.IsDefault = obj.IsDefault
+	g.Users = &[]int64{}
+	for _, u := range obj.Users {
+		*g.Users = append(*g.Users, u.ID)
+	}
+	return nil
+}
+
+func (c *Client) GetUserGroup(organisationID, groupID int64) (*UserGroup, error) {
+	url := fmt.Sprintf("%s/organisations/%d/groups/%d/", c.baseURL, organisationID, groupID)
+	group := UserGroup{}
+	resp, err := c.client.R().SetResult(&group).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "user group", id: strconv.FormatInt(groupID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching user group: %s", resp)
+	}
+	group.OrganisationID = organisationID
+	return &group, nil
+}
+
+func (c *Client) CreateUserGroup(group *UserGroup) error {
+	url := fmt.Sprintf("%s/organisations/%d/groups/", c.baseURL, group.OrganisationID)
+	resp, err := c.client.R().SetBody(group).SetResult(group).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating user group: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) UpdateUserGroup(group *UserGroup) error {
+	url := fmt.Sprintf("%s/organisations/%d/groups/%d/", c.baseURL, group.OrganisationID, *group.ID)
+	resp, err := c.client.R().SetBody(group).SetResult(group).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating user group: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) DeleteUserGroup(organisationID, groupID int64) error {
+	url := fmt.Sprintf("%s/organisations/%d/groups/%d/", c.baseURL, organisationID, groupID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting user group: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) manageUserGroupMembers(group *UserGroup, userIDs []int64, endpoint string) (*resty.Response, error) {
+	url := fmt.Sprintf("%s/organisations/%d/groups/%d/%s/", c.baseURL, group.OrganisationID, *group.ID, endpoint)
+	body := struct {
+		UserIDs []int64 `json:"user_ids"`
+	}{
+		UserIDs: userIDs,
+	}
+	return c.client.R().SetBody(body).Post(url)
+}
+
+func (c *Client) AddUserGroupMembers(group *UserGroup, userIDs []int64) error {
+	resp, err := c.manageUserGroupMembers(group, userIDs, "add-users")
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error adding user group members: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) RemoveUserGroupMembers(group *UserGroup, userIDs []int64) error {
+	resp, err := c.manageUserGroupMembers(group, userIDs, "remove-users")
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error removing user group members: %s", resp)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package flagsmith
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type Webhook struct {
+	ID      *int64 `json:"id,omitempty"`
+	URL     string `json:"url"`
+	Enabled bool   `json:"enabled"`
+	Secret  string `json:"secret"`
+}
+
+func (c *Client) getWebhook(webhooksURL string, webhookID int64) (*Webhook, error) {
+	url := fmt.Sprintf("%s%d/", webhooksURL, webhookID)
+	webhook := Webhook{}
+	resp, err := c.client.R().SetResult(&webhook).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		if isNotFound(resp) {
+			return nil, NotFoundError{kind: "webhook", id: strconv.FormatInt(webhookID, 10)}
+		}
+		return nil, fmt.Errorf("flagsmith: Error fetching webhook: %s", resp)
+	}
+	return &webhook, nil
+}
+
+func (c *Client) createWebhook(webhooksURL string, webhook *Webhook) error {
+	resp, err := c.client.R().SetBody(webhook).SetResult(webhook).Post(webhooksURL)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error creating webhook: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) updateWebhook(webhooksURL string, webhook *Webhook) error {
+	url := fmt.Sprintf("%s%d/", webhooksURL, *webhook.ID)
+	resp, err := c.client.R().SetBody(webhook).SetResult(webhook).Put(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error updating webhook: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) deleteWebhook(webhooksURL string, webhookID int64) error {
+	url := fmt.Sprintf("%s%d/", webhooksURL, webhookID)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmith: Error deleting webhook: %s", resp)
+	}
+	return nil
+}
+
+func (c *Client) environmentWebhooksURL(environmentKey string) string {
+	return fmt.Sprintf("%s/environments/%s/webhooks/", c.baseURL, environmentKey)
+}
+
+func (c *Client) GetEnvironmentWebhook(environmentKey string, webhookID int64) (*Webhook, error) {
+	return c.getWebhook(c.environmentWebhooksURL(environmentKey), webhookID)
+}
+
+func (c *Client) CreateEnvironmentWebhook(environmentKey string, webhook *Webhook) error {
+	return c.createWebhook(c.environmentWebhooksURL(environmentKey), webhook)
+}
+
+func (c *Client) UpdateEnvironmentWebhook(environmentKey string, webhook *Webhook) error {
+	return c.updateWebhook(c.environmentWebhooksURL(environmentKey), webhook)
+}
+
+func (c *Client) DeleteEnvironmentWebhook(environmentKey string, webhookID int64) error {
+	return c.deleteWebhook(c.environmentWebhooksURL(environmentKey), webhookID)
+}
+
+func (c *Client) organisationWebhooksURL(organisationID int64) string {
+	return fmt.Sprintf("%s/organisations/%d/webhooks/", c.baseURL, organisationID)
+}
+
+func (c *Client) GetOrganisationWebhook(organisationID, webhookID int64) (*Webhook, error) {
+	return c.getWebhook(c.organisationWebhooksURL(organisationID), webhookID)
+}
+
+func (c *Client) CreateOrganisationWebhook(organisationID int64, webhook *Webhook) error {
+	return c.createWebhook(c.organisationWebhooksURL(organisationID), webhook)
+}
+
+func (c *Client) UpdateOrganisationWebhook(organisationID int64, webhook *Webhook) error {
+	return c.updateWebhook(c.organisationWebhooksURL(organisationID), webhook)
+}
+
+func (c *Client) DeleteOrganisationWebhook(organisationID, webhookID int64) error {
+	return c.deleteWebhook(c.organisationWebhooksURL(organisationID), webhookID)
+}
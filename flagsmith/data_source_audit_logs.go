@@ -0,0 +1,145 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &auditLogsDataResource{}
+
+func newAuditLogsDataResource() datasource.DataSource {
+	return &auditLogsDataResource{}
+}
+
+type auditLogsDataResource struct {
+	client *Client
+}
+
+func (a *auditLogsDataResource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (a *auditLogsDataResource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *auditLogsDataResource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Audit Logs: audit log entries of the organisations the master API key belongs to, newest first. " +
+			"Every page matching the filters is fetched, use `from` to bound the number of requests",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the entries of the project with this ID",
+			},
+			"environment_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the entries of the environment with this ID",
+			},
+			"search": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the entries whose log contains this text",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the entries created at or after this RFC3339 timestamp(e.g: `2024-01-02T15:04:05Z`)",
+				Validators:          []validator.String{rfc3339Validator{}},
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the entries created at or before this RFC3339 timestamp(e.g: `2024-01-02T15:04:05Z`)",
+				Validators:          []validator.String{rfc3339Validator{}},
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Audit log entries, newest first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the entry",
+						},
+						"created_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Creation date of the entry",
+						},
+						"log": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the change",
+						},
+						"author": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Email of the user who made the change, null for system events and changes made using API keys",
+						},
+						"project_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the project of the change, if any",
+						},
+						"environment_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the environment of the change, if any",
+						},
+						"related_object_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the changed object(e.g: `FEATURE`, `FEATURE_STATE`, `SEGMENT`)",
+						},
+						"related_object_uuid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "UUID of the changed object, if any",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *auditLogsDataResource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the config is wrong
+	if diags.HasError() {
+		return
+	}
+
+	query, err := data.ToClientAuditLogQuery()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Date Range", err.Error())
+		return
+	}
+	logs, err := a.client.GetAuditLogs(query)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit logs, got error: %s", err))
+		return
+	}
+	data.Entries = []AuditLogEntryData{}
+	for i := range logs {
+		data.Entries = append(data.Entries, MakeAuditLogEntryDataFromClientAuditLog(&logs[i]))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
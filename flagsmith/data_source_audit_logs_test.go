@@ -0,0 +1,50 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccAuditLogsDataResource(t *testing.T) {
+	featureName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditLogsDataResourceConfig(featureName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.flagsmith_audit_logs.test_logs", "entries.0.id"),
+					resource.TestCheckResourceAttrSet("data.flagsmith_audit_logs.test_logs", "entries.0.created_date"),
+					resource.TestCheckResourceAttr("data.flagsmith_audit_logs.test_logs", "entries.0.project_id", strconv.Itoa(projectID())),
+					resource.TestCheckResourceAttr("data.flagsmith_audit_logs.test_logs", "entries.0.related_object_type", "FEATURE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditLogsDataResourceConfig(featureName string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_feature" "test_feature" {
+  feature_name = "%s"
+  project_uuid = "%s"
+  type         = "STANDARD"
+}
+
+data "flagsmith_audit_logs" "test_logs" {
+  project_id = %d
+  search     = flagsmith_feature.test_feature.feature_name
+  from       = timeadd(plantimestamp(), "-1h")
+}
+
+`, featureName, projectUUID(), projectID())
+}
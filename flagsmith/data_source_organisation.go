@@ -3,7 +3,6 @@ package flagsmith
 import (
 	"context"
 	"fmt"
-	"github.com/Flagsmith/flagsmith-go-api-client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -16,7 +15,7 @@ func newOrganisationDataResource() datasource.DataSource {
 }
 
 type organisationDataResource struct {
-	client *flagsmithapi.Client
+	client *Client
 }
 
 func (o *organisationDataResource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -29,11 +28,11 @@ func (o *organisationDataResource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*flagsmithapi.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *flagsmithapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
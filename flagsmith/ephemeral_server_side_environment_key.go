@@ -0,0 +1,178 @@
+package flagsmith
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResource = &serverSideEnvironmentKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &serverSideEnvironmentKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &serverSideEnvironmentKeyEphemeralResource{}
+
+// privateKeyData is the key of the private data used to find the key on close
+const privateKeyData = "server_side_environment_key"
+
+type serverSideEnvironmentKeyPrivateData struct {
+	EnvironmentKey    string     `json:"environment_key"`
+	ID                int64      `json:"id"`
+	Name              string     `json:"name"`
+	ExpiresAt         *time.Time `json:"expires_at"`
+	DeactivateOnClose bool       `json:"deactivate_on_close"`
+}
+
+func newServerSideEnvironmentKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &serverSideEnvironmentKeyEphemeralResource{}
+}
+
+type serverSideEnvironmentKeyEphemeralResource struct {
+	client *Client
+}
+
+func (r *serverSideEnvironmentKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_side_environment_key"
+}
+
+func (r *serverSideEnvironmentKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *serverSideEnvironmentKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Server Side Environment Key that only exists for the duration of a Terraform run. " +
+			"The key is never stored in the state and is deleted(or deactivated) once Terraform is done with it",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the server side key",
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side key of the environment",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the server side key",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "RFC3339 timestamp after which the key can not be used anymore",
+				Validators:          []validator.String{rfc3339Validator{}},
+			},
+			"deactivate_on_close": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "If true, the key is deactivated instead of being deleted when the ephemeral resource is closed. Defaults to false. " +
+					"NOTE: ephemeral resources are opened on every plan and apply, hence an inactive key is left behind for each of them",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The server side key(e.g: `ser.xxxx`)",
+			},
+		},
+	}
+}
+
+func (r *serverSideEnvironmentKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ServerSideEnvironmentKeyEphemeralResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientKey := flagsmithapi.ServerSideEnvKey{
+		Name:   data.Name.ValueString(),
+		Active: true,
+	}
+	if !data.ExpiresAt.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Timestamp", fmt.Sprintf("Unable to parse expires_at, got error: %s", err))
+			return
+		}
+		clientKey.ExpiresAt = &expiresAt
+	}
+	err := r.client.CreateServerSideEnvKey(data.EnvironmentKey.ValueString(), &clientKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create server side environment key, got error: %s", err))
+		return
+	}
+
+	privateData, err := json.Marshal(serverSideEnvironmentKeyPrivateData{
+		EnvironmentKey:    data.EnvironmentKey.ValueString(),
+		ID:                clientKey.ID,
+		Name:              clientKey.Name,
+		ExpiresAt:         clientKey.ExpiresAt,
+		DeactivateOnClose: data.DeactivateOnClose.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to store the key id, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyData, privateData)...)
+
+	data.ID = types.Int64Value(clientKey.ID)
+	data.Key = types.StringValue(clientKey.Key)
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *serverSideEnvironmentKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, privateKeyData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+	var data serverSideEnvironmentKeyPrivateData
+	err := json.Unmarshal(privateData, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to read the key id, got error: %s", err))
+		return
+	}
+
+	if data.DeactivateOnClose {
+		// The key is updated as a whole, hence the expiry must be sent back for it to be kept
+		err = r.client.UpdateServerSideEnvKey(data.EnvironmentKey, &flagsmithapi.ServerSideEnvKey{
+			ID:        data.ID,
+			Name:      data.Name,
+			ExpiresAt: data.ExpiresAt,
+			Active:    false,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate server side environment key, got error: %s", err))
+		}
+		return
+	}
+	err = r.client.DeleteServerSideEnvKey(data.EnvironmentKey, data.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete server side environment key, got error: %s", err))
+	}
+}
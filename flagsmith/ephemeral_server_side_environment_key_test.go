@@ -0,0 +1,107 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"testing"
+)
+
+func TestAccServerSideEnvironmentKeyEphemeralResource(t *testing.T) {
+	keyName := acctest.RandString(16)
+	deactivatedKeyName := acctest.RandString(16)
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Ephemeral resources are only supported by Terraform 1.10 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// The key must be gone once the ephemeral resource is closed
+			{
+				Config: testAccServerSideEnvironmentKeyEphemeralResourceConfig(keyName),
+				Check:  testAccCheckServerSideEnvironmentKeyClosed(keyName),
+			},
+
+			// The key must be deactivated, keeping its expiry, once the ephemeral resource is closed
+			{
+				Config: testAccDeactivatedServerSideEnvironmentKeyEphemeralResourceConfig(deactivatedKeyName, expiresAt),
+				Check:  testAccCheckServerSideEnvironmentKeyDeactivated(deactivatedKeyName, expiresAt),
+			},
+		},
+	})
+}
+
+func testAccCheckServerSideEnvironmentKeyClosed(keyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keys, err := testClient().GetServerSideEnvKeys(environmentKey())
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if key.Name == keyName {
+				return fmt.Errorf("server side environment key still exists")
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckServerSideEnvironmentKeyDeactivated(keyName string, expiresAt time.Time) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keys, err := testClient().GetServerSideEnvKeys(environmentKey())
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if key.Name != keyName {
+				continue
+			}
+			// The deactivated key is left behind, remove it once checked
+			defer func() { _ = testClient().DeleteServerSideEnvKey(environmentKey(), key.ID) }()
+			if key.Active {
+				return fmt.Errorf("server side environment key is still active")
+			}
+			if key.ExpiresAt == nil || !key.ExpiresAt.Equal(expiresAt) {
+				return fmt.Errorf("expected server side environment key to expire at %s, got: %v", expiresAt, key.ExpiresAt)
+			}
+			return nil
+		}
+		return fmt.Errorf("server side environment key not found")
+	}
+}
+
+func testAccServerSideEnvironmentKeyEphemeralResourceConfig(keyName string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+ephemeral "flagsmith_server_side_environment_key" "test_key" {
+  environment_key = "%s"
+  name            = "%s"
+}
+
+`, environmentKey(), keyName)
+}
+
+func testAccDeactivatedServerSideEnvironmentKeyEphemeralResourceConfig(keyName string, expiresAt time.Time) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+ephemeral "flagsmith_server_side_environment_key" "test_key" {
+  environment_key     = "%s"
+  name                = "%s"
+  expires_at          = "%s"
+  deactivate_on_close = true
+}
+
+`, environmentKey(), keyName, expiresAt.Format(time.RFC3339))
+}
@@ -1,9 +1,18 @@
 package flagsmith
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"math"
 	"math/big"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
 )
 
 type FeatureStateValue struct {
@@ -77,6 +86,59 @@ type FeatureStateResourceData struct {
 	Segment           types.Int64        `tfsdk:"segment_id"`
 	SegmentPriority   types.Int64        `tfsdk:"segment_priority"`
 	FeatureSegment    types.Int64        `tfsdk:"feature_segment_id"`
+
+	ChangeRequest          *FeatureStateChangeRequest `tfsdk:"change_request"`
+	PendingChangeRequestID types.Int64                `tfsdk:"pending_change_request_id"`
+	LiveFrom               types.String               `tfsdk:"live_from"`
+
+	MultivariateValues []MultivariateValue `tfsdk:"multivariate_values"`
+}
+
+// MultivariateValue is the percentage allocation of a multivariate option in a feature state
+type MultivariateValue struct {
+	MultivariateOptionUUID types.String  `tfsdk:"multivariate_option_uuid"`
+	PercentageAllocation   types.Float64 `tfsdk:"percentage_allocation"`
+}
+
+type FeatureStateChangeRequest struct {
+	Title            types.String  `tfsdk:"title"`
+	Description      types.String  `tfsdk:"description"`
+	ApproverUserIDs  []types.Int64 `tfsdk:"approver_user_ids"`
+	ApproverGroupIDs []types.Int64 `tfsdk:"approver_group_ids"`
+	WaitForApproval  types.Bool    `tfsdk:"wait_for_approval"`
+	Timeout          types.String  `tfsdk:"timeout"`
+}
+
+// ToClientChangeRequest returns a change request that updates the feature state
+// to the values of the resource data, scheduled at `live_from` if set
+func (f *FeatureStateResourceData) ToClientChangeRequest() *ChangeRequest {
+	fs := f.ToClientFS()
+	changeRequest := ChangeRequest{
+		FeatureStates: []ChangeRequestFeatureState{{
+			Feature:           fs.Feature,
+			Enabled:           fs.Enabled,
+			FeatureStateValue: fs.FeatureStateValue,
+			FeatureSegment:    fs.FeatureSegment,
+			LiveFrom:          f.LiveFrom.ValueStringPointer(),
+		}},
+		Approvals:        []ChangeRequestApproval{},
+		GroupAssignments: []ChangeRequestGroupAssignment{},
+	}
+	// Scheduled changes without a change request block are made through a change request
+	// that is committed straight away
+	if f.ChangeRequest == nil {
+		changeRequest.Title = "Scheduled change of feature " + strconv.FormatInt(fs.Feature, 10)
+		return &changeRequest
+	}
+	changeRequest.Title = f.ChangeRequest.Title.ValueString()
+	changeRequest.Description = f.ChangeRequest.Description.ValueString()
+	for _, userID := range f.ChangeRequest.ApproverUserIDs {
+		changeRequest.Approvals = append(changeRequest.Approvals, ChangeRequestApproval{User: userID.ValueInt64()})
+	}
+	for _, groupID := range f.ChangeRequest.ApproverGroupIDs {
+		changeRequest.GroupAssignments = append(changeRequest.GroupAssignments, ChangeRequestGroupAssignment{Group: groupID.ValueInt64()})
+	}
+	return &changeRequest
 }
 
 func (f *FeatureStateResourceData) ToClientFS() *flagsmithapi.FeatureState {
@@ -121,6 +183,9 @@ func MakeFeatureStateResourceDataFromClientFS(clientFS *flagsmithapi.FeatureStat
 		Segment:           types.Int64Null(),
 		SegmentPriority:   types.Int64Null(),
 		FeatureSegment:    types.Int64Null(),
+
+		PendingChangeRequestID: types.Int64Null(),
+		LiveFrom:               types.StringNull(),
 	}
 	if clientFS.FeatureSegment != nil {
 		featureSegment := types.Int64Value(*clientFS.FeatureSegment)
@@ -141,6 +206,78 @@ func MakeFeatureStateResourceDataFromClientFS(clientFS *flagsmithapi.FeatureStat
 
 }
 
+// ToClientMultivariateFeatureStateValues returns the multivariate values to save on the feature state, given the
+// multivariate options of the feature and the current values of the feature state. Current values of the options
+// that are not listed are set to 0
+func (f *FeatureStateResourceData) ToClientMultivariateFeatureStateValues(options []flagsmithapi.FeatureMultivariateOption, current []MultivariateFeatureStateValue) ([]MultivariateFeatureStateValue, error) {
+	optionIDs := map[string]int64{}
+	for _, option := range options {
+		optionIDs[option.UUID] = option.ID
+	}
+	var optionOrder []int64
+	percentages := map[int64]float64{}
+	for _, value := range f.MultivariateValues {
+		optionID, ok := optionIDs[value.MultivariateOptionUUID.ValueString()]
+		if !ok {
+			return nil, fmt.Errorf("multivariate option %q does not belong to feature %d", value.MultivariateOptionUUID.ValueString(), f.Feature.ValueInt64())
+		}
+		optionOrder = append(optionOrder, optionID)
+		percentages[optionID] = value.PercentageAllocation.ValueFloat64()
+	}
+
+	values := []MultivariateFeatureStateValue{}
+	for _, value := range current {
+		value.PercentageAllocation = percentages[value.MultivariateFeatureOption]
+		values = append(values, value)
+		delete(percentages, value.MultivariateFeatureOption)
+	}
+	for _, optionID := range optionOrder {
+		if percentage, ok := percentages[optionID]; ok {
+			values = append(values, MultivariateFeatureStateValue{MultivariateFeatureOption: optionID, PercentageAllocation: percentage})
+		}
+	}
+	return values, nil
+}
+
+// MakeMultivariateValuesFromClient returns the multivariate values of a feature state. The options listed in
+// `prior`(i.e: the configured ones) come first and in the same order, with a percentage allocation of 0 if they
+// have no value, followed by the other options that have a percentage allocation
+func MakeMultivariateValuesFromClient(clientValues []MultivariateFeatureStateValue, options []flagsmithapi.FeatureMultivariateOption, prior []MultivariateValue) []MultivariateValue {
+	optionUUIDs := map[int64]string{}
+	for _, option := range options {
+		optionUUIDs[option.ID] = option.UUID
+	}
+	percentages := map[string]float64{}
+	for _, value := range clientValues {
+		percentages[optionUUIDs[value.MultivariateFeatureOption]] = value.PercentageAllocation
+	}
+
+	values := []MultivariateValue{}
+	for _, value := range prior {
+		optionUUID := value.MultivariateOptionUUID.ValueString()
+		// The API has no value for options that are not allocated
+		percentage := percentages[optionUUID]
+		values = append(values, MultivariateValue{
+			MultivariateOptionUUID: types.StringValue(optionUUID),
+			PercentageAllocation:   types.Float64Value(percentage),
+		})
+		delete(percentages, optionUUID)
+	}
+	for _, value := range clientValues {
+		optionUUID := optionUUIDs[value.MultivariateFeatureOption]
+		percentage, ok := percentages[optionUUID]
+		if !ok || percentage == 0 {
+			continue
+		}
+		values = append(values, MultivariateValue{
+			MultivariateOptionUUID: types.StringValue(optionUUID),
+			PercentageAllocation:   types.Float64Value(percentage),
+		})
+		delete(percentages, optionUUID)
+	}
+	return values
+}
+
 type MultivariateOptionResourceData struct {
 	Type                        types.String `tfsdk:"type"`
 	ID                          types.Int64  `tfsdk:"id"`
@@ -213,18 +350,19 @@ func (m *MultivariateOptionResourceData) ToClientMultivariateOption() *flagsmith
 }
 
 type FeatureResourceData struct {
-	UUID           types.String   `tfsdk:"uuid"`
-	ID             types.Int64    `tfsdk:"id"`
-	Name           types.String   `tfsdk:"feature_name"`
-	Type           types.String   `tfsdk:"type"`
-	Description    types.String   `tfsdk:"description"`
-	InitialValue   types.String   `tfsdk:"initial_value"`
-	DefaultEnabled types.Bool     `tfsdk:"default_enabled"`
-	IsArchived     types.Bool     `tfsdk:"is_archived"`
-	Owners         *[]types.Int64 `tfsdk:"owners"`
-	Tags           *[]types.Int64 `tfsdk:"tags"`
-	ProjectID      types.Int64    `tfsdk:"project_id"`
-	ProjectUUID    types.String   `tfsdk:"project_uuid"`
+	UUID           types.String            `tfsdk:"uuid"`
+	ID             types.Int64             `tfsdk:"id"`
+	Name           types.String            `tfsdk:"feature_name"`
+	Type           types.String            `tfsdk:"type"`
+	Description    types.String            `tfsdk:"description"`
+	InitialValue   types.String            `tfsdk:"initial_value"`
+	DefaultEnabled types.Bool              `tfsdk:"default_enabled"`
+	IsArchived     types.Bool              `tfsdk:"is_archived"`
+	Owners         *[]types.Int64          `tfsdk:"owners"`
+	Tags           *[]types.Int64          `tfsdk:"tags"`
+	ProjectID      types.Int64             `tfsdk:"project_id"`
+	ProjectUUID    types.String            `tfsdk:"project_uuid"`
+	Metadata       map[string]types.String `tfsdk:"metadata"`
 }
 
 func (f *FeatureResourceData) ToClientFeature() *flagsmithapi.Feature {
@@ -394,14 +532,15 @@ func MakeRuleFromClientRule(clientRule *flagsmithapi.Rule) Rule {
 }
 
 type SegmentResourceData struct {
-	ID          types.Int64  `tfsdk:"id"`
-	UUID        types.String `tfsdk:"uuid"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	ProjectID   types.Int64  `tfsdk:"project_id"`
-	ProjectUUID types.String `tfsdk:"project_uuid"`
-	FeatureID   types.Int64  `tfsdk:"feature_id"`
-	Rules       []Rule       `tfsdk:"rules"`
+	ID          types.Int64             `tfsdk:"id"`
+	UUID        types.String            `tfsdk:"uuid"`
+	Name        types.String            `tfsdk:"name"`
+	Description types.String            `tfsdk:"description"`
+	ProjectID   types.Int64             `tfsdk:"project_id"`
+	ProjectUUID types.String            `tfsdk:"project_uuid"`
+	FeatureID   types.Int64             `tfsdk:"feature_id"`
+	Rules       []Rule                  `tfsdk:"rules"`
+	Metadata    map[string]types.String `tfsdk:"metadata"`
 }
 
 func (s *SegmentResourceData) ToClientSegment() *flagsmithapi.Segment {
@@ -581,6 +720,112 @@ func MakeOrganisationResourceDataFromClientOrganisation(clientOrganisation *flag
 	return resourceData
 }
 
+// ManagedOrganisationResourceData is the data of the `flagsmith_organisation` resource, the data source
+// uses OrganisationResourceData
+type ManagedOrganisationResourceData struct {
+	ID                           types.Int64  `tfsdk:"id"`
+	UUID                         types.String `tfsdk:"uuid"`
+	Name                         types.String `tfsdk:"name"`
+	Force2FA                     types.Bool   `tfsdk:"force_2fa"`
+	PersistTraitData             types.Bool   `tfsdk:"persist_trait_data"`
+	RestrictProjectCreateToAdmin types.Bool   `tfsdk:"restrict_project_create_to_admin"`
+	WebhookNotificationEmail     types.String `tfsdk:"webhook_notification_email"`
+	DeleteOnDestroy              types.Bool   `tfsdk:"delete_on_destroy"`
+}
+
+// ToClientOrganisation applies the settings of the resource data to the current organisation, settings
+// that are unknown(i.e: not configured) keep their current value
+func (o *ManagedOrganisationResourceData) ToClientOrganisation(current *Organisation) *Organisation {
+	organisation := *current
+	if !o.Force2FA.IsUnknown() && !o.Force2FA.IsNull() {
+		organisation.Force2FA = o.Force2FA.ValueBool()
+	}
+	if !o.PersistTraitData.IsUnknown() && !o.PersistTraitData.IsNull() {
+		organisation.PersistTraitData = o.PersistTraitData.ValueBool()
+	}
+	if !o.RestrictProjectCreateToAdmin.IsUnknown() && !o.RestrictProjectCreateToAdmin.IsNull() {
+		organisation.RestrictProjectCreateToAdmin = o.RestrictProjectCreateToAdmin.ValueBool()
+	}
+	organisation.WebhookNotificationEmail = o.WebhookNotificationEmail.ValueStringPointer()
+	return &organisation
+}
+
+func MakeManagedOrganisationResourceDataFromClientOrganisation(clientOrganisation *Organisation, deleteOnDestroy types.Bool) ManagedOrganisationResourceData {
+	resourceData := ManagedOrganisationResourceData{
+		ID:                           types.Int64Value(clientOrganisation.ID),
+		UUID:                         types.StringValue(clientOrganisation.UUID),
+		Name:                         types.StringValue(clientOrganisation.Name),
+		Force2FA:                     types.BoolValue(clientOrganisation.Force2FA),
+		PersistTraitData:             types.BoolValue(clientOrganisation.PersistTraitData),
+		RestrictProjectCreateToAdmin: types.BoolValue(clientOrganisation.RestrictProjectCreateToAdmin),
+		WebhookNotificationEmail:     types.StringNull(),
+		DeleteOnDestroy:              deleteOnDestroy,
+	}
+	if clientOrganisation.WebhookNotificationEmail != nil && *clientOrganisation.WebhookNotificationEmail != "" {
+		resourceData.WebhookNotificationEmail = types.StringValue(*clientOrganisation.WebhookNotificationEmail)
+	}
+	return resourceData
+}
+
+type OrganisationInviteResourceData struct {
+	ID             types.Int64   `tfsdk:"id"`
+	OrganisationID types.Int64   `tfsdk:"organisation_id"`
+	Email          types.String  `tfsdk:"email"`
+	Role           types.String  `tfsdk:"role"`
+	Groups         []types.Int64 `tfsdk:"groups"`
+}
+
+func (i *OrganisationInviteResourceData) ToClientOrganisationInvite() *OrganisationInvite {
+	invite := OrganisationInvite{
+		Email:            i.Email.ValueString(),
+		Role:             i.Role.ValueString(),
+		PermissionGroups: []int64{},
+		OrganisationID:   i.OrganisationID.ValueInt64(),
+	}
+	if !i.ID.IsNull() && !i.ID.IsUnknown() {
+		inviteID := i.ID.ValueInt64()
+		invite.ID = &inviteID
+	}
+	for _, group := range i.Groups {
+		invite.PermissionGroups = append(invite.PermissionGroups, group.ValueInt64())
+	}
+	return &invite
+}
+
+func MakeOrganisationInviteResourceDataFromClientOrganisationInvite(clientInvite *OrganisationInvite) OrganisationInviteResourceData {
+	resourceData := OrganisationInviteResourceData{
+		ID:             types.Int64Null(),
+		OrganisationID: types.Int64Value(clientInvite.OrganisationID),
+		Email:          types.StringValue(clientInvite.Email),
+		Role:           types.StringValue(clientInvite.Role),
+		Groups:         []types.Int64{},
+	}
+	// The ID is not known if the invite could not be looked up once created
+	if clientInvite.ID != nil {
+		resourceData.ID = types.Int64Value(*clientInvite.ID)
+	}
+	for _, group := range clientInvite.PermissionGroups {
+		resourceData.Groups = append(resourceData.Groups, types.Int64Value(group))
+	}
+	return resourceData
+}
+
+type OrganisationUserResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	OrganisationID types.Int64  `tfsdk:"organisation_id"`
+	Email          types.String `tfsdk:"email"`
+	Role           types.String `tfsdk:"role"`
+}
+
+func MakeOrganisationUserResourceDataFromClientOrganisationUser(clientUser *OrganisationUser, organisationID int64) OrganisationUserResourceData {
+	return OrganisationUserResourceData{
+		ID:             types.Int64Value(clientUser.ID),
+		OrganisationID: types.Int64Value(organisationID),
+		Email:          types.StringValue(clientUser.Email),
+		Role:           types.StringValue(clientUser.Role),
+	}
+}
+
 type EnvironmentResourceData struct {
 	ID          types.Int64  `tfsdk:"id"`
 	UUID        types.String `tfsdk:"uuid"`
@@ -595,6 +840,9 @@ type EnvironmentResourceData struct {
 	HideSensitiveData types.Bool `tfsdk:"hide_sensitive_data"`
 	UseIdentityCompositeKeyForHashing types.Bool `tfsdk:"use_identity_composite_key_for_hashing"`
 	MinimumChangeRequestApprovals types.Int64 `tfsdk:"minimum_change_request_approvals"`
+	UseV2FeatureVersioning types.Bool `tfsdk:"use_v2_feature_versioning"`
+	Metadata map[string]types.String `tfsdk:"metadata"`
+	CloneFromEnvironmentKey types.String `tfsdk:"clone_from_environment_key"`
 
 }
 
@@ -644,3 +892,1477 @@ func MakeEnvironmentResourceDataFromClientEnvironment(clientEnvironment *flagsmi
 	}
 	return resourceData
 }
+
+type TraitValue struct {
+	Type         types.String  `tfsdk:"type"`
+	StringValue  types.String  `tfsdk:"string_value"`
+	IntegerValue types.Int64   `tfsdk:"integer_value"`
+	FloatValue   types.Float64 `tfsdk:"float_value"`
+	BooleanValue types.Bool    `tfsdk:"boolean_value"`
+}
+
+func (t TraitValue) Equal(other TraitValue) bool {
+	return t.Type.Equal(other.Type) &&
+		t.StringValue.Equal(other.StringValue) &&
+		t.IntegerValue.Equal(other.IntegerValue) &&
+		t.FloatValue.Equal(other.FloatValue) &&
+		t.BooleanValue.Equal(other.BooleanValue)
+}
+
+// traitValueAttributes are the names of the value attributes of each type of trait
+var traitValueAttributes = map[string]string{
+	"unicode": "string_value",
+	"int":     "integer_value",
+	"float":   "float_value",
+	"bool":    "boolean_value",
+}
+
+// ValidateType returns an error if the value attribute that is set does not match the type of the trait.
+// Unknown types are not checked
+func (t TraitValue) ValidateType() error {
+	if t.Type.IsNull() || t.Type.IsUnknown() {
+		return nil
+	}
+	values := map[string]attr.Value{
+		"unicode": t.StringValue,
+		"int":     t.IntegerValue,
+		"float":   t.FloatValue,
+		"bool":    t.BooleanValue,
+	}
+	value, ok := values[t.Type.ValueString()]
+	if !ok || !value.IsNull() {
+		return nil
+	}
+	return fmt.Errorf("type is %q, hence %s must be set", t.Type.ValueString(), traitValueAttributes[t.Type.ValueString()])
+}
+
+func (t *TraitValue) ToClientTrait(traitKey string) *flagsmithapi.Trait {
+	trait := flagsmithapi.Trait{
+		TraitKey:  traitKey,
+		ValueType: t.Type.ValueString(),
+	}
+	switch t.Type.ValueString() {
+	case "unicode":
+		value := t.StringValue.ValueString()
+		trait.StringValue = &value
+	case "int":
+		value := int(t.IntegerValue.ValueInt64())
+		trait.IntegerValue = &value
+	case "float":
+		value := t.FloatValue.ValueFloat64()
+		trait.FloatValue = &value
+	case "bool":
+		value := t.BooleanValue.ValueBool()
+		trait.BooleanValue = &value
+	}
+	return &trait
+}
+
+func (t *TraitValue) ToEdgeTrait(traitKey string) *EdgeTrait {
+	var value interface{}
+	switch t.Type.ValueString() {
+	case "unicode":
+		value = t.StringValue.ValueString()
+	case "int":
+		value = t.IntegerValue.ValueInt64()
+	case "float":
+		// Always keep the decimal point so that the value is read back as float
+		value = json.Number(strconv.FormatFloat(t.FloatValue.ValueFloat64(), 'f', 1, 64))
+		if t.FloatValue.ValueFloat64() != math.Trunc(t.FloatValue.ValueFloat64()) {
+			value = json.Number(strconv.FormatFloat(t.FloatValue.ValueFloat64(), 'f', -1, 64))
+		}
+	case "bool":
+		value = t.BooleanValue.ValueBool()
+	}
+	rawValue, _ := json.Marshal(value)
+	return &EdgeTrait{TraitKey: traitKey, TraitValue: rawValue}
+}
+
+func MakeTraitValueFromClientTrait(clientTrait *flagsmithapi.Trait) TraitValue {
+	traitValue := TraitValue{
+		Type:         types.StringValue(clientTrait.ValueType),
+		StringValue:  types.StringNull(),
+		IntegerValue: types.Int64Null(),
+		FloatValue:   types.Float64Null(),
+		BooleanValue: types.BoolNull(),
+	}
+	switch clientTrait.ValueType {
+	case "unicode":
+		if clientTrait.StringValue == nil {
+			traitValue.StringValue = types.StringValue("")
+		} else {
+			traitValue.StringValue = types.StringValue(*clientTrait.StringValue)
+		}
+	// The value is left null if the API did not return it
+	case "int":
+		if clientTrait.IntegerValue != nil {
+			traitValue.IntegerValue = types.Int64Value(int64(*clientTrait.IntegerValue))
+		}
+	case "float":
+		if clientTrait.FloatValue != nil {
+			traitValue.FloatValue = types.Float64Value(*clientTrait.FloatValue)
+		}
+	case "bool":
+		if clientTrait.BooleanValue != nil {
+			traitValue.BooleanValue = types.BoolValue(*clientTrait.BooleanValue)
+		}
+	}
+	return traitValue
+}
+
+// Edge traits are stored as raw json values, hence the type of the trait
+// is inferred from the value itself
+func MakeTraitValueFromEdgeTrait(edgeTrait *EdgeTrait) TraitValue {
+	traitValue := TraitValue{
+		StringValue:  types.StringNull(),
+		IntegerValue: types.Int64Null(),
+		FloatValue:   types.Float64Null(),
+		BooleanValue: types.BoolNull(),
+	}
+	decoder := json.NewDecoder(bytes.NewReader(edgeTrait.TraitValue))
+	decoder.UseNumber()
+
+	var value interface{}
+	_ = decoder.Decode(&value)
+
+	switch v := value.(type) {
+	case string:
+		traitValue.Type = types.StringValue("unicode")
+		traitValue.StringValue = types.StringValue(v)
+	case bool:
+		traitValue.Type = types.StringValue("bool")
+		traitValue.BooleanValue = types.BoolValue(v)
+	case json.Number:
+		if intValue, err := v.Int64(); err == nil {
+			traitValue.Type = types.StringValue("int")
+			traitValue.IntegerValue = types.Int64Value(intValue)
+		} else {
+			floatValue, _ := v.Float64()
+			traitValue.Type = types.StringValue("float")
+			traitValue.FloatValue = types.Float64Value(floatValue)
+		}
+	}
+	return traitValue
+}
+
+type IdentityResourceData struct {
+	ID             types.Int64           `tfsdk:"id"`
+	IdentityUUID   types.String          `tfsdk:"identity_uuid"`
+	Identifier     types.String          `tfsdk:"identifier"`
+	EnvironmentKey types.String          `tfsdk:"environment_key"`
+	Traits         map[string]TraitValue `tfsdk:"traits"`
+}
+
+type IdentityFeatureStateResourceData struct {
+	ID                types.Int64        `tfsdk:"id"`
+	UUID              types.String       `tfsdk:"uuid"`
+	EnvironmentKey    types.String       `tfsdk:"environment_key"`
+	Feature           types.Int64        `tfsdk:"feature_id"`
+	Identifier        types.String       `tfsdk:"identifier"`
+	IdentityID        types.Int64        `tfsdk:"identity_id"`
+	IdentityUUID      types.String       `tfsdk:"identity_uuid"`
+	Enabled           types.Bool         `tfsdk:"enabled"`
+	FeatureStateValue *FeatureStateValue `tfsdk:"feature_state_value"`
+}
+
+func (f *IdentityFeatureStateResourceData) ToClientIdentityFS() *IdentityFeatureState {
+	fs := IdentityFeatureState{
+		UUID:              f.UUID.ValueString(),
+		Feature:           f.Feature.ValueInt64(),
+		Enabled:           f.Enabled.ValueBool(),
+		FeatureStateValue: f.FeatureStateValue.ToClientFSV(),
+	}
+	if !f.ID.IsNull() && !f.ID.IsUnknown() {
+		fs.ID = f.ID.ValueInt64()
+	}
+	return &fs
+}
+
+// Generate a new IdentityFeatureStateResourceData from client `IdentityFeatureState`.
+// NOTE: identity and environment fields are not part of the client object and must be set by the caller
+func MakeIdentityFeatureStateResourceDataFromClientFS(clientFS *IdentityFeatureState) IdentityFeatureStateResourceData {
+	fsValue := MakeFeatureStateValueFromClientFSV(clientFS.FeatureStateValue)
+	fs := IdentityFeatureStateResourceData{
+		ID:                types.Int64Null(),
+		UUID:              types.StringValue(clientFS.UUID),
+		Feature:           types.Int64Value(clientFS.Feature),
+		Enabled:           types.BoolValue(clientFS.Enabled),
+		FeatureStateValue: &fsValue,
+	}
+	// Edge feature states do not have an ID
+	if clientFS.ID != 0 {
+		fs.ID = types.Int64Value(clientFS.ID)
+	}
+	return fs
+}
+
+type UserGroupResourceData struct {
+	ID             types.Int64    `tfsdk:"id"`
+	OrganisationID types.Int64    `tfsdk:"organisation_id"`
+	Name           types.String   `tfsdk:"name"`
+	ExternalID     types.String   `tfsdk:"external_id"`
+	IsDefault      types.Bool     `tfsdk:"is_default"`
+	Users          *[]types.Int64 `tfsdk:"users"`
+}
+
+func (g *UserGroupResourceData) ToClientUserGroup() *UserGroup {
+	group := UserGroup{
+		Name:           g.Name.ValueString(),
+		IsDefault:      g.IsDefault.ValueBool(),
+		OrganisationID: g.OrganisationID.ValueInt64(),
+		Users:          &[]int64{},
+	}
+	if !g.ID.IsNull() && !g.ID.IsUnknown() {
+		groupID := g.ID.ValueInt64()
+		group.ID = &groupID
+	}
+	if g.ExternalID.ValueString() != "" {
+		externalID := g.ExternalID.ValueString()
+		group.ExternalID = &externalID
+	}
+	if g.Users == nil {
+		group.Users = nil
+	}
+	if g.Users != nil {
+		for _, user := range *g.Users {
+			*group.Users = append(*group.Users, user.ValueInt64())
+		}
+	}
+	return &group
+}
+
+func MakeUserGroupResourceDataFromClientUserGroup(clientGroup *UserGroup) UserGroupResourceData {
+	resourceData := UserGroupResourceData{
+		ID:             types.Int64Value(*clientGroup.ID),
+		OrganisationID: types.Int64Value(clientGroup.OrganisationID),
+		Name:           types.StringValue(clientGroup.Name),
+		ExternalID:     types.StringNull(),
+		IsDefault:      types.BoolValue(clientGroup.IsDefault),
+	}
+	if clientGroup.ExternalID != nil && *clientGroup.ExternalID != "" {
+		resourceData.ExternalID = types.StringValue(*clientGroup.ExternalID)
+	}
+	if clientGroup.Users != nil {
+		resourceData.Users = &[]types.Int64{}
+		for _, user := range *clientGroup.Users {
+			*resourceData.Users = append(*resourceData.Users, types.Int64Value(user))
+		}
+	}
+	return resourceData
+}
+
+type ProjectPermissionResourceData struct {
+	ID          types.Int64    `tfsdk:"id"`
+	ProjectUUID types.String   `tfsdk:"project_uuid"`
+	ProjectID   types.Int64    `tfsdk:"project_id"`
+	UserID      types.Int64    `tfsdk:"user_id"`
+	GroupID     types.Int64    `tfsdk:"group_id"`
+	Admin       types.Bool     `tfsdk:"admin"`
+	Permissions []types.String `tfsdk:"permissions"`
+}
+
+func (p *ProjectPermissionResourceData) ToClientPermission() *ObjectPermission {
+	return makeClientObjectPermission(p.ID, p.UserID, p.GroupID, p.Admin, p.Permissions)
+}
+
+func MakeProjectPermissionResourceDataFromClientPermission(clientPermission *ObjectPermission, projectUUID string, projectID int64) ProjectPermissionResourceData {
+	resourceData := ProjectPermissionResourceData{
+		ID:          types.Int64Value(*clientPermission.ID),
+		ProjectUUID: types.StringValue(projectUUID),
+		ProjectID:   types.Int64Value(projectID),
+		UserID:      types.Int64PointerValue(clientPermission.User),
+		GroupID:     types.Int64PointerValue(clientPermission.Group),
+		Admin:       types.BoolValue(clientPermission.Admin),
+		Permissions: makePermissionKeys(clientPermission.Permissions),
+	}
+	return resourceData
+}
+
+func makeClientObjectPermission(id, userID, groupID types.Int64, admin types.Bool, permissions []types.String) *ObjectPermission {
+	permission := ObjectPermission{
+		Admin:       admin.ValueBool(),
+		Permissions: []string{},
+	}
+	if !id.IsNull() && !id.IsUnknown() {
+		permissionID := id.ValueInt64()
+		permission.ID = &permissionID
+	}
+	if !userID.IsNull() {
+		user := userID.ValueInt64()
+		permission.User = &user
+	}
+	if !groupID.IsNull() {
+		group := groupID.ValueInt64()
+		permission.Group = &group
+	}
+	for _, key := range permissions {
+		permission.Permissions = append(permission.Permissions, key.ValueString())
+	}
+	return &permission
+}
+
+func makePermissionKeys(permissions []string) []types.String {
+	keys := make([]string, len(permissions))
+	copy(keys, permissions)
+	sort.Strings(keys)
+
+	result := []types.String{}
+	for _, key := range keys {
+		result = append(result, types.StringValue(key))
+	}
+	return result
+}
+
+type EnvironmentPermissionResourceData struct {
+	ID              types.Int64    `tfsdk:"id"`
+	EnvironmentKey  types.String   `tfsdk:"environment_key"`
+	EnvironmentUUID types.String   `tfsdk:"environment_uuid"`
+	UserID          types.Int64    `tfsdk:"user_id"`
+	GroupID         types.Int64    `tfsdk:"group_id"`
+	Admin           types.Bool     `tfsdk:"admin"`
+	Permissions     []types.String `tfsdk:"permissions"`
+}
+
+func (p *EnvironmentPermissionResourceData) ToClientPermission() *ObjectPermission {
+	return makeClientObjectPermission(p.ID, p.UserID, p.GroupID, p.Admin, p.Permissions)
+}
+
+func MakeEnvironmentPermissionResourceDataFromClientPermission(clientPermission *ObjectPermission, environmentKey, environmentUUID string) EnvironmentPermissionResourceData {
+	resourceData := EnvironmentPermissionResourceData{
+		ID:              types.Int64Value(*clientPermission.ID),
+		EnvironmentKey:  types.StringValue(environmentKey),
+		EnvironmentUUID: types.StringValue(environmentUUID),
+		UserID:          types.Int64PointerValue(clientPermission.User),
+		GroupID:         types.Int64PointerValue(clientPermission.Group),
+		Admin:           types.BoolValue(clientPermission.Admin),
+		Permissions:     makePermissionKeys(clientPermission.Permissions),
+	}
+	return resourceData
+}
+
+type RoleResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	OrganisationID types.Int64  `tfsdk:"organisation_id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+}
+
+func (r *RoleResourceData) ToClientRole() *Role {
+	role := Role{
+		Name:           r.Name.ValueString(),
+		Description:    r.Description.ValueString(),
+		OrganisationID: r.OrganisationID.ValueInt64(),
+	}
+	if !r.ID.IsNull() && !r.ID.IsUnknown() {
+		roleID := r.ID.ValueInt64()
+		role.ID = &roleID
+	}
+	return &role
+}
+
+func MakeRoleResourceDataFromClientRole(clientRole *Role) RoleResourceData {
+	resourceData := RoleResourceData{
+		ID:             types.Int64Value(*clientRole.ID),
+		OrganisationID: types.Int64Value(clientRole.OrganisationID),
+		Name:           types.StringValue(clientRole.Name),
+		Description:    types.StringNull(),
+	}
+	if clientRole.Description != "" {
+		resourceData.Description = types.StringValue(clientRole.Description)
+	}
+	return resourceData
+}
+
+type RoleOrganisationPermissionResourceData struct {
+	ID             types.Int64    `tfsdk:"id"`
+	OrganisationID types.Int64    `tfsdk:"organisation_id"`
+	RoleID         types.Int64    `tfsdk:"role_id"`
+	Permissions    []types.String `tfsdk:"permissions"`
+}
+
+func (p *RoleOrganisationPermissionResourceData) ToClientRolePermission() *RolePermission {
+	return makeClientRolePermission(p.ID, p.RoleID, nil, p.Permissions)
+}
+
+func MakeRoleOrganisationPermissionResourceDataFromClientRolePermission(clientPermission *RolePermission, organisationID int64) RoleOrganisationPermissionResourceData {
+	return RoleOrganisationPermissionResourceData{
+		ID:             types.Int64Value(*clientPermission.ID),
+		OrganisationID: types.Int64Value(organisationID),
+		RoleID:         types.Int64Value(clientPermission.Role),
+		Permissions:    makePermissionKeys(clientPermission.Permissions),
+	}
+}
+
+type RoleProjectPermissionResourceData struct {
+	ID             types.Int64    `tfsdk:"id"`
+	OrganisationID types.Int64    `tfsdk:"organisation_id"`
+	RoleID         types.Int64    `tfsdk:"role_id"`
+	ProjectUUID    types.String   `tfsdk:"project_uuid"`
+	ProjectID      types.Int64    `tfsdk:"project_id"`
+	Admin          types.Bool     `tfsdk:"admin"`
+	Permissions    []types.String `tfsdk:"permissions"`
+}
+
+func (p *RoleProjectPermissionResourceData) ToClientRolePermission() *RolePermission {
+	permission := makeClientRolePermission(p.ID, p.RoleID, &p.Admin, p.Permissions)
+	if !p.ProjectID.IsNull() && !p.ProjectID.IsUnknown() {
+		projectID := p.ProjectID.ValueInt64()
+		permission.Project = &projectID
+	}
+	return permission
+}
+
+func MakeRoleProjectPermissionResourceDataFromClientRolePermission(clientPermission *RolePermission, organisationID int64, projectUUID string) RoleProjectPermissionResourceData {
+	return RoleProjectPermissionResourceData{
+		ID:             types.Int64Value(*clientPermission.ID),
+		OrganisationID: types.Int64Value(organisationID),
+		RoleID:         types.Int64Value(clientPermission.Role),
+		ProjectUUID:    types.StringValue(projectUUID),
+		ProjectID:      types.Int64PointerValue(clientPermission.Project),
+		Admin:          types.BoolValue(clientPermission.Admin != nil && *clientPermission.Admin),
+		Permissions:    makePermissionKeys(clientPermission.Permissions),
+	}
+}
+
+type RoleEnvironmentPermissionResourceData struct {
+	ID             types.Int64    `tfsdk:"id"`
+	OrganisationID types.Int64    `tfsdk:"organisation_id"`
+	RoleID         types.Int64    `tfsdk:"role_id"`
+	EnvironmentKey types.String   `tfsdk:"environment_key"`
+	EnvironmentID  types.Int64    `tfsdk:"environment_id"`
+	Admin          types.Bool     `tfsdk:"admin"`
+	Permissions    []types.String `tfsdk:"permissions"`
+}
+
+func (p *RoleEnvironmentPermissionResourceData) ToClientRolePermission() *RolePermission {
+	permission := makeClientRolePermission(p.ID, p.RoleID, &p.Admin, p.Permissions)
+	if !p.EnvironmentID.IsNull() && !p.EnvironmentID.IsUnknown() {
+		environmentID := p.EnvironmentID.ValueInt64()
+		permission.Environment = &environmentID
+	}
+	return permission
+}
+
+func MakeRoleEnvironmentPermissionResourceDataFromClientRolePermission(clientPermission *RolePermission, organisationID int64, environmentKey string) RoleEnvironmentPermissionResourceData {
+	return RoleEnvironmentPermissionResourceData{
+		ID:             types.Int64Value(*clientPermission.ID),
+		OrganisationID: types.Int64Value(organisationID),
+		RoleID:         types.Int64Value(clientPermission.Role),
+		EnvironmentKey: types.StringValue(environmentKey),
+		EnvironmentID:  types.Int64PointerValue(clientPermission.Environment),
+		Admin:          types.BoolValue(clientPermission.Admin != nil && *clientPermission.Admin),
+		Permissions:    makePermissionKeys(clientPermission.Permissions),
+	}
+}
+
+func makeClientRolePermission(id, roleID types.Int64, admin *types.Bool, permissions []types.String) *RolePermission {
+	permission := RolePermission{
+		Role:        roleID.ValueInt64(),
+		Permissions: []string{},
+	}
+	if !id.IsNull() && !id.IsUnknown() {
+		permissionID := id.ValueInt64()
+		permission.ID = &permissionID
+	}
+	if admin != nil {
+		isAdmin := admin.ValueBool()
+		permission.Admin = &isAdmin
+	}
+	for _, key := range permissions {
+		permission.Permissions = append(permission.Permissions, key.ValueString())
+	}
+	return &permission
+}
+
+type RoleAssignmentResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	OrganisationID types.Int64  `tfsdk:"organisation_id"`
+	RoleID         types.Int64  `tfsdk:"role_id"`
+	UserID         types.Int64  `tfsdk:"user_id"`
+	GroupID        types.Int64  `tfsdk:"group_id"`
+	MasterAPIKeyID types.String `tfsdk:"master_api_key_id"`
+}
+
+func (a *RoleAssignmentResourceData) ToClientRoleAssignment() *RoleAssignment {
+	assignment := RoleAssignment{
+		Role:         a.RoleID.ValueInt64(),
+		User:         a.UserID.ValueInt64Pointer(),
+		Group:        a.GroupID.ValueInt64Pointer(),
+		MasterAPIKey: a.MasterAPIKeyID.ValueStringPointer(),
+	}
+	if !a.ID.IsNull() && !a.ID.IsUnknown() {
+		assignmentID := a.ID.ValueInt64()
+		assignment.ID = &assignmentID
+	}
+	return &assignment
+}
+
+func MakeRoleAssignmentResourceDataFromClientRoleAssignment(clientAssignment *RoleAssignment, organisationID int64) RoleAssignmentResourceData {
+	return RoleAssignmentResourceData{
+		ID:             types.Int64Value(*clientAssignment.ID),
+		OrganisationID: types.Int64Value(organisationID),
+		RoleID:         types.Int64Value(clientAssignment.Role),
+		UserID:         types.Int64PointerValue(clientAssignment.User),
+		GroupID:        types.Int64PointerValue(clientAssignment.Group),
+		MasterAPIKeyID: types.StringPointerValue(clientAssignment.MasterAPIKey),
+	}
+}
+
+type ServerSideEnvironmentKeyResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	EnvironmentKey types.String `tfsdk:"environment_key"`
+	Name           types.String `tfsdk:"name"`
+	Active         types.Bool   `tfsdk:"active"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Key            types.String `tfsdk:"key"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Keepers        types.Map    `tfsdk:"keepers"`
+}
+
+func (k *ServerSideEnvironmentKeyResourceData) ToClientServerSideEnvKey() (*flagsmithapi.ServerSideEnvKey, error) {
+	key := flagsmithapi.ServerSideEnvKey{
+		ID:     k.ID.ValueInt64(),
+		Name:   k.Name.ValueString(),
+		Active: k.Active.ValueBool(),
+	}
+	if !k.ExpiresAt.IsNull() && !k.ExpiresAt.IsUnknown() {
+		expiresAt, err := time.Parse(time.RFC3339, k.ExpiresAt.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		key.ExpiresAt = &expiresAt
+	}
+	return &key, nil
+}
+
+// MakeServerSideEnvironmentKeyResourceDataFromClientKey builds the resource data from the client key.
+// `key` and `keepers` are taken from the given data since the key is only returned on creation
+// and keepers are not stored in Flagsmith; `expires_at` is kept as is if it's the same instant
+func MakeServerSideEnvironmentKeyResourceDataFromClientKey(clientKey *flagsmithapi.ServerSideEnvKey, environmentKey string, data *ServerSideEnvironmentKeyResourceData) ServerSideEnvironmentKeyResourceData {
+	resourceData := ServerSideEnvironmentKeyResourceData{
+		ID:             types.Int64Value(clientKey.ID),
+		EnvironmentKey: types.StringValue(environmentKey),
+		Name:           types.StringValue(clientKey.Name),
+		Active:         types.BoolValue(clientKey.Active),
+		ExpiresAt:      types.StringNull(),
+		Key:            data.Key,
+		CreatedAt:      types.StringNull(),
+		Keepers:        data.Keepers,
+	}
+	if clientKey.Key != "" {
+		resourceData.Key = types.StringValue(clientKey.Key)
+	}
+	if clientKey.ExpiresAt != nil {
+		resourceData.ExpiresAt = types.StringValue(clientKey.ExpiresAt.Format(time.RFC3339))
+		if currentExpiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString()); err == nil && currentExpiresAt.Equal(*clientKey.ExpiresAt) {
+			resourceData.ExpiresAt = data.ExpiresAt
+		}
+	}
+	if clientKey.CreatedAt != nil {
+		resourceData.CreatedAt = types.StringValue(clientKey.CreatedAt.Format(time.RFC3339))
+	}
+	return resourceData
+}
+
+type ServerSideEnvironmentKeyEphemeralResourceData struct {
+	ID                types.Int64  `tfsdk:"id"`
+	EnvironmentKey    types.String `tfsdk:"environment_key"`
+	Name              types.String `tfsdk:"name"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	DeactivateOnClose types.Bool   `tfsdk:"deactivate_on_close"`
+	Key               types.String `tfsdk:"key"`
+}
+
+type MasterAPIKeyResourceData struct {
+	ID             types.String   `tfsdk:"id"`
+	Prefix         types.String   `tfsdk:"prefix"`
+	OrganisationID types.Int64    `tfsdk:"organisation_id"`
+	Name           types.String   `tfsdk:"name"`
+	ExpiryDate     types.String   `tfsdk:"expiry_date"`
+	IsAdmin        types.Bool     `tfsdk:"is_admin"`
+	Roles          *[]types.Int64 `tfsdk:"roles"`
+	Key            types.String   `tfsdk:"key"`
+}
+
+func (k *MasterAPIKeyResourceData) ToClientMasterAPIKey() (*MasterAPIKey, error) {
+	key := MasterAPIKey{
+		ID:             k.ID.ValueString(),
+		Prefix:         k.Prefix.ValueString(),
+		Name:           k.Name.ValueString(),
+		IsAdmin:        k.IsAdmin.ValueBool(),
+		OrganisationID: k.OrganisationID.ValueInt64(),
+	}
+	if !k.ExpiryDate.IsNull() && !k.ExpiryDate.IsUnknown() {
+		expiryDate, err := time.Parse(time.RFC3339, k.ExpiryDate.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		key.ExpiryDate = &expiryDate
+	}
+	return &key, nil
+}
+
+// RoleIDs returns the IDs of the roles assigned to the key(nil if roles are not managed)
+func (k *MasterAPIKeyResourceData) RoleIDs() *[]int64 {
+	if k.Roles == nil {
+		return nil
+	}
+	roleIDs := []int64{}
+	for _, role := range *k.Roles {
+		roleIDs = append(roleIDs, role.ValueInt64())
+	}
+	return &roleIDs
+}
+
+// MakeMasterAPIKeyResourceDataFromClientMasterAPIKey builds the resource data from the client key.
+// The key itself is only returned on creation, hence it's taken from the given data
+// along with the roles; `expiry_date` is kept as is if it's the same instant
+func MakeMasterAPIKeyResourceDataFromClientMasterAPIKey(clientKey *MasterAPIKey, data *MasterAPIKeyResourceData) MasterAPIKeyResourceData {
+	resourceData := MasterAPIKeyResourceData{
+		ID:             types.StringValue(clientKey.ID),
+		Prefix:         types.StringValue(clientKey.Prefix),
+		OrganisationID: data.OrganisationID,
+		Name:           types.StringValue(clientKey.Name),
+		ExpiryDate:     types.StringNull(),
+		IsAdmin:        types.BoolValue(clientKey.IsAdmin),
+		Roles:          data.Roles,
+		Key:            data.Key,
+	}
+	if clientKey.Key != "" {
+		resourceData.Key = types.StringValue(clientKey.Key)
+	}
+	if resourceData.Key.IsUnknown() {
+		resourceData.Key = types.StringNull()
+	}
+	if clientKey.ExpiryDate != nil {
+		resourceData.ExpiryDate = types.StringValue(clientKey.ExpiryDate.Format(time.RFC3339))
+		if currentExpiryDate, err := time.Parse(time.RFC3339, data.ExpiryDate.ValueString()); err == nil && currentExpiryDate.Equal(*clientKey.ExpiryDate) {
+			resourceData.ExpiryDate = data.ExpiryDate
+		}
+	}
+	return resourceData
+}
+
+type EnvironmentWebhookResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	EnvironmentKey types.String `tfsdk:"environment_key"`
+	URL            types.String `tfsdk:"url"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Secret         types.String `tfsdk:"secret"`
+}
+
+func (w *EnvironmentWebhookResourceData) ToClientWebhook() *Webhook {
+	return makeClientWebhook(w.ID, w.URL, w.Enabled, w.Secret)
+}
+
+func MakeEnvironmentWebhookResourceDataFromClientWebhook(clientWebhook *Webhook, environmentKey string, secret types.String) EnvironmentWebhookResourceData {
+	return EnvironmentWebhookResourceData{
+		ID:             types.Int64Value(*clientWebhook.ID),
+		EnvironmentKey: types.StringValue(environmentKey),
+		URL:            types.StringValue(clientWebhook.URL),
+		Enabled:        types.BoolValue(clientWebhook.Enabled),
+		Secret:         makeWebhookSecret(clientWebhook, secret),
+	}
+}
+
+func makeClientWebhook(id types.Int64, url types.String, enabled types.Bool, secret types.String) *Webhook {
+	webhook := Webhook{
+		URL:     url.ValueString(),
+		Enabled: enabled.ValueBool(),
+		Secret:  secret.ValueString(),
+	}
+	if !id.IsNull() && !id.IsUnknown() {
+		webhookID := id.ValueInt64()
+		webhook.ID = &webhookID
+	}
+	return &webhook
+}
+
+// makeWebhookSecret returns the secret of the webhook. The current secret is kept
+// if the API does not return it
+func makeWebhookSecret(clientWebhook *Webhook, secret types.String) types.String {
+	if clientWebhook.Secret != "" {
+		return types.StringValue(clientWebhook.Secret)
+	}
+	if secret.IsUnknown() {
+		return types.StringNull()
+	}
+	return secret
+}
+
+type OrganisationWebhookResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	OrganisationID types.Int64  `tfsdk:"organisation_id"`
+	URL            types.String `tfsdk:"url"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Secret         types.String `tfsdk:"secret"`
+}
+
+func (w *OrganisationWebhookResourceData) ToClientWebhook() *Webhook {
+	return makeClientWebhook(w.ID, w.URL, w.Enabled, w.Secret)
+}
+
+func MakeOrganisationWebhookResourceDataFromClientWebhook(clientWebhook *Webhook, organisationID int64, secret types.String) OrganisationWebhookResourceData {
+	return OrganisationWebhookResourceData{
+		ID:             types.Int64Value(*clientWebhook.ID),
+		OrganisationID: types.Int64Value(organisationID),
+		URL:            types.StringValue(clientWebhook.URL),
+		Enabled:        types.BoolValue(clientWebhook.Enabled),
+		Secret:         makeWebhookSecret(clientWebhook, secret),
+	}
+}
+
+type AmplitudeIntegrationConfig struct {
+	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+type SegmentIntegrationConfig struct {
+	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+type MixpanelIntegrationConfig struct {
+	APIKey types.String `tfsdk:"api_key"`
+}
+
+type HeapIntegrationConfig struct {
+	APIKey types.String `tfsdk:"api_key"`
+}
+
+type RudderStackIntegrationConfig struct {
+	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+type EnvironmentIntegrationResourceData struct {
+	ID             types.Int64                   `tfsdk:"id"`
+	EnvironmentKey types.String                  `tfsdk:"environment_key"`
+	Type           types.String                  `tfsdk:"type"`
+	Amplitude      *AmplitudeIntegrationConfig   `tfsdk:"amplitude"`
+	Segment        *SegmentIntegrationConfig     `tfsdk:"segment"`
+	Mixpanel       *MixpanelIntegrationConfig    `tfsdk:"mixpanel"`
+	Heap           *HeapIntegrationConfig        `tfsdk:"heap"`
+	RudderStack    *RudderStackIntegrationConfig `tfsdk:"rudderstack"`
+}
+
+func (e *EnvironmentIntegrationResourceData) ToClientEnvironmentIntegration() *EnvironmentIntegration {
+	integration := EnvironmentIntegration{}
+	switch e.Type.ValueString() {
+	case "amplitude":
+		if e.Amplitude != nil {
+			integration.APIKey = e.Amplitude.APIKey.ValueString()
+			integration.BaseURL = e.Amplitude.BaseURL.ValueStringPointer()
+		}
+	case "segment":
+		if e.Segment != nil {
+			integration.APIKey = e.Segment.APIKey.ValueString()
+			integration.BaseURL = e.Segment.BaseURL.ValueStringPointer()
+		}
+	case "mixpanel":
+		if e.Mixpanel != nil {
+			integration.APIKey = e.Mixpanel.APIKey.ValueString()
+		}
+	case "heap":
+		if e.Heap != nil {
+			integration.APIKey = e.Heap.APIKey.ValueString()
+		}
+	case "rudderstack":
+		if e.RudderStack != nil {
+			integration.APIKey = e.RudderStack.APIKey.ValueString()
+			integration.BaseURL = e.RudderStack.BaseURL.ValueStringPointer()
+		}
+	}
+	if !e.ID.IsNull() && !e.ID.IsUnknown() {
+		integrationID := e.ID.ValueInt64()
+		integration.ID = &integrationID
+	}
+	return &integration
+}
+
+func MakeEnvironmentIntegrationResourceDataFromClientEnvironmentIntegration(clientIntegration *EnvironmentIntegration, environmentKey, integrationType string) EnvironmentIntegrationResourceData {
+	resourceData := EnvironmentIntegrationResourceData{
+		ID:             types.Int64Value(*clientIntegration.ID),
+		EnvironmentKey: types.StringValue(environmentKey),
+		Type:           types.StringValue(integrationType),
+	}
+	apiKey := types.StringValue(clientIntegration.APIKey)
+	baseURL := types.StringNull()
+	if clientIntegration.BaseURL != nil && *clientIntegration.BaseURL != "" {
+		baseURL = types.StringValue(*clientIntegration.BaseURL)
+	}
+	switch integrationType {
+	case "amplitude":
+		resourceData.Amplitude = &AmplitudeIntegrationConfig{APIKey: apiKey, BaseURL: baseURL}
+	case "segment":
+		resourceData.Segment = &SegmentIntegrationConfig{APIKey: apiKey, BaseURL: baseURL}
+	case "mixpanel":
+		resourceData.Mixpanel = &MixpanelIntegrationConfig{APIKey: apiKey}
+	case "heap":
+		resourceData.Heap = &HeapIntegrationConfig{APIKey: apiKey}
+	case "rudderstack":
+		resourceData.RudderStack = &RudderStackIntegrationConfig{APIKey: apiKey, BaseURL: baseURL}
+	}
+	return resourceData
+}
+
+type DatadogIntegrationConfig struct {
+	APIKey          types.String `tfsdk:"api_key"`
+	BaseURL         types.String `tfsdk:"base_url"`
+	UseCustomSource types.Bool   `tfsdk:"use_custom_source"`
+}
+
+type NewRelicIntegrationConfig struct {
+	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+	AppID   types.String `tfsdk:"app_id"`
+}
+
+type DynatraceIntegrationConfig struct {
+	APIKey         types.String `tfsdk:"api_key"`
+	BaseURL        types.String `tfsdk:"base_url"`
+	EntitySelector types.String `tfsdk:"entity_selector"`
+}
+
+type GrafanaIntegrationConfig struct {
+	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+type ProjectIntegrationResourceData struct {
+	ID          types.Int64                 `tfsdk:"id"`
+	ProjectUUID types.String                `tfsdk:"project_uuid"`
+	ProjectID   types.Int64                 `tfsdk:"project_id"`
+	Type        types.String                `tfsdk:"type"`
+	Datadog     *DatadogIntegrationConfig   `tfsdk:"datadog"`
+	NewRelic    *NewRelicIntegrationConfig  `tfsdk:"new_relic"`
+	Dynatrace   *DynatraceIntegrationConfig `tfsdk:"dynatrace"`
+	Grafana     *GrafanaIntegrationConfig   `tfsdk:"grafana"`
+}
+
+func (p *ProjectIntegrationResourceData) ToClientProjectIntegration() *ProjectIntegration {
+	integration := ProjectIntegration{}
+	switch p.Type.ValueString() {
+	case "datadog":
+		if p.Datadog != nil {
+			integration.APIKey = p.Datadog.APIKey.ValueString()
+			integration.BaseURL = p.Datadog.BaseURL.ValueString()
+			integration.UseCustomSource = p.Datadog.UseCustomSource.ValueBoolPointer()
+		}
+	case "new_relic":
+		if p.NewRelic != nil {
+			integration.APIKey = p.NewRelic.APIKey.ValueString()
+			integration.BaseURL = p.NewRelic.BaseURL.ValueString()
+			integration.AppID = p.NewRelic.AppID.ValueStringPointer()
+		}
+	case "dynatrace":
+		if p.Dynatrace != nil {
+			integration.APIKey = p.Dynatrace.APIKey.ValueString()
+			integration.BaseURL = p.Dynatrace.BaseURL.ValueString()
+			// Send an empty selector to clear it
+			entitySelector := p.Dynatrace.EntitySelector.ValueString()
+			integration.EntitySelector = &entitySelector
+		}
+	case "grafana":
+		if p.Grafana != nil {
+			integration.APIKey = p.Grafana.APIKey.ValueString()
+			integration.BaseURL = p.Grafana.BaseURL.ValueString()
+		}
+	}
+	if !p.ID.IsNull() && !p.ID.IsUnknown() {
+		integrationID := p.ID.ValueInt64()
+		integration.ID = &integrationID
+	}
+	return &integration
+}
+
+func MakeProjectIntegrationResourceDataFromClientProjectIntegration(clientIntegration *ProjectIntegration, projectUUID string, projectID int64, integrationType string) ProjectIntegrationResourceData {
+	resourceData := ProjectIntegrationResourceData{
+		ID:          types.Int64Value(*clientIntegration.ID),
+		ProjectUUID: types.StringValue(projectUUID),
+		ProjectID:   types.Int64Value(projectID),
+		Type:        types.StringValue(integrationType),
+	}
+	apiKey := types.StringValue(clientIntegration.APIKey)
+	baseURL := types.StringValue(clientIntegration.BaseURL)
+	switch integrationType {
+	case "datadog":
+		useCustomSource := clientIntegration.UseCustomSource != nil && *clientIntegration.UseCustomSource
+		resourceData.Datadog = &DatadogIntegrationConfig{APIKey: apiKey, BaseURL: baseURL, UseCustomSource: types.BoolValue(useCustomSource)}
+	case "new_relic":
+		resourceData.NewRelic = &NewRelicIntegrationConfig{APIKey: apiKey, BaseURL: baseURL, AppID: types.StringPointerValue(clientIntegration.AppID)}
+	case "dynatrace":
+		entitySelector := types.StringNull()
+		if clientIntegration.EntitySelector != nil && *clientIntegration.EntitySelector != "" {
+			entitySelector = types.StringValue(*clientIntegration.EntitySelector)
+		}
+		resourceData.Dynatrace = &DynatraceIntegrationConfig{APIKey: apiKey, BaseURL: baseURL, EntitySelector: entitySelector}
+	case "grafana":
+		resourceData.Grafana = &GrafanaIntegrationConfig{APIKey: apiKey, BaseURL: baseURL}
+	}
+	return resourceData
+}
+
+type ProjectSlackIntegrationResourceData struct {
+	ID          types.Int64  `tfsdk:"id"`
+	ProjectUUID types.String `tfsdk:"project_uuid"`
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	APIToken    types.String `tfsdk:"api_token"`
+}
+
+func (p *ProjectSlackIntegrationResourceData) ToClientSlackProjectConfiguration() *SlackProjectConfiguration {
+	configuration := SlackProjectConfiguration{
+		APIToken: p.APIToken.ValueString(),
+	}
+	if !p.ID.IsNull() && !p.ID.IsUnknown() {
+		configurationID := p.ID.ValueInt64()
+		configuration.ID = &configurationID
+	}
+	return &configuration
+}
+
+// MakeProjectSlackIntegrationResourceDataFromClientConfiguration builds the resource data from the
+// API response, the token is not returned by the API hence the current one is kept
+func MakeProjectSlackIntegrationResourceDataFromClientConfiguration(clientConfiguration *SlackProjectConfiguration, projectUUID string, projectID int64, apiToken types.String) ProjectSlackIntegrationResourceData {
+	if apiToken.IsUnknown() {
+		apiToken = types.StringNull()
+	}
+	return ProjectSlackIntegrationResourceData{
+		ID:          types.Int64Value(*clientConfiguration.ID),
+		ProjectUUID: types.StringValue(projectUUID),
+		ProjectID:   types.Int64Value(projectID),
+		APIToken:    apiToken,
+	}
+}
+
+type EnvironmentSlackChannelResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	EnvironmentKey types.String `tfsdk:"environment_key"`
+	ChannelName    types.String `tfsdk:"channel_name"`
+	ChannelID      types.String `tfsdk:"channel_id"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+func (e *EnvironmentSlackChannelResourceData) ToClientSlackEnvironmentConfiguration(channelID string) *SlackEnvironmentConfiguration {
+	configuration := SlackEnvironmentConfiguration{
+		ChannelID: channelID,
+		Enabled:   e.Enabled.ValueBool(),
+	}
+	if !e.ID.IsNull() && !e.ID.IsUnknown() {
+		configurationID := e.ID.ValueInt64()
+		configuration.ID = &configurationID
+	}
+	return &configuration
+}
+
+func MakeEnvironmentSlackChannelResourceDataFromClientConfiguration(clientConfiguration *SlackEnvironmentConfiguration, environmentKey, channelName string) EnvironmentSlackChannelResourceData {
+	return EnvironmentSlackChannelResourceData{
+		ID:             types.Int64Value(*clientConfiguration.ID),
+		EnvironmentKey: types.StringValue(environmentKey),
+		ChannelName:    types.StringValue(channelName),
+		ChannelID:      types.StringValue(clientConfiguration.ChannelID),
+		Enabled:        types.BoolValue(clientConfiguration.Enabled),
+	}
+}
+
+type SegmentOverrideResourceData struct {
+	Segment           types.Int64        `tfsdk:"segment_id"`
+	Enabled           types.Bool         `tfsdk:"enabled"`
+	FeatureStateValue *FeatureStateValue `tfsdk:"feature_state_value"`
+}
+
+type EnvironmentFeatureVersionResourceData struct {
+	UUID              types.String                  `tfsdk:"uuid"`
+	EnvironmentKey    types.String                  `tfsdk:"environment_key"`
+	Environment       types.Int64                   `tfsdk:"environment_id"`
+	Feature           types.Int64                   `tfsdk:"feature_id"`
+	Enabled           types.Bool                    `tfsdk:"enabled"`
+	FeatureStateValue *FeatureStateValue            `tfsdk:"feature_state_value"`
+	SegmentOverrides  []SegmentOverrideResourceData `tfsdk:"segment_overrides"`
+	VersionHistory    types.List                    `tfsdk:"version_history"`
+}
+
+// ToClientFeatureVersionChanges returns the changes required to go from the feature states of the live
+// version to the desired ones. The priority of a segment override is its position in `segment_overrides`
+func (f *EnvironmentFeatureVersionResourceData) ToClientFeatureVersionChanges(liveFeatureStates []FeatureVersionFeatureState) *FeatureVersionChanges {
+	changes := FeatureVersionChanges{
+		PublishImmediately:    true,
+		FeatureStatesToCreate: []FeatureVersionFeatureState{},
+		FeatureStatesToUpdate: []FeatureVersionFeatureState{{
+			Enabled:           f.Enabled.ValueBool(),
+			FeatureStateValue: f.FeatureStateValue.ToClientFSV(),
+		}},
+		SegmentIDsToDeleteOverrides: []int64{},
+	}
+	liveSegments := map[int64]bool{}
+	for _, featureState := range liveFeatureStates {
+		if featureState.SegmentID != nil {
+			liveSegments[*featureState.SegmentID] = true
+		}
+	}
+	desiredSegments := map[int64]bool{}
+	for i, override := range f.SegmentOverrides {
+		segmentID := override.Segment.ValueInt64()
+		priority := int64(i)
+		featureState := FeatureVersionFeatureState{
+			Enabled:           override.Enabled.ValueBool(),
+			FeatureStateValue: override.FeatureStateValue.ToClientFSV(),
+			SegmentID:         &segmentID,
+			SegmentPriority:   &priority,
+		}
+		desiredSegments[segmentID] = true
+		if liveSegments[segmentID] {
+			changes.FeatureStatesToUpdate = append(changes.FeatureStatesToUpdate, featureState)
+		} else {
+			changes.FeatureStatesToCreate = append(changes.FeatureStatesToCreate, featureState)
+		}
+	}
+	for _, featureState := range liveFeatureStates {
+		if featureState.SegmentID != nil && !desiredSegments[*featureState.SegmentID] {
+			changes.SegmentIDsToDeleteOverrides = append(changes.SegmentIDsToDeleteOverrides, *featureState.SegmentID)
+		}
+	}
+	return &changes
+}
+
+func MakeEnvironmentFeatureVersionResourceDataFromClientVersions(versions []FeatureVersion, liveFeatureStates []FeatureVersionFeatureState, environmentKey string, environmentID, featureID int64) EnvironmentFeatureVersionResourceData {
+	history := []attr.Value{}
+	for _, version := range versions {
+		history = append(history, types.StringValue(version.UUID))
+	}
+	resourceData := EnvironmentFeatureVersionResourceData{
+		UUID:           types.StringNull(),
+		EnvironmentKey: types.StringValue(environmentKey),
+		Environment:    types.Int64Value(environmentID),
+		Feature:        types.Int64Value(featureID),
+		Enabled:        types.BoolValue(false),
+		VersionHistory: types.ListValueMust(types.StringType, history),
+	}
+	if len(versions) > 0 {
+		resourceData.UUID = types.StringValue(versions[len(versions)-1].UUID)
+	}
+
+	overrides := []FeatureVersionFeatureState{}
+	for _, featureState := range liveFeatureStates {
+		if featureState.SegmentID != nil {
+			overrides = append(overrides, featureState)
+			continue
+		}
+		resourceData.Enabled = types.BoolValue(featureState.Enabled)
+		if featureState.FeatureStateValue != nil {
+			fsValue := MakeFeatureStateValueFromClientFSV(featureState.FeatureStateValue)
+			resourceData.FeatureStateValue = &fsValue
+		}
+	}
+	sort.SliceStable(overrides, func(i, j int) bool {
+		if overrides[i].SegmentPriority == nil || overrides[j].SegmentPriority == nil {
+			return overrides[j].SegmentPriority == nil && overrides[i].SegmentPriority != nil
+		}
+		return *overrides[i].SegmentPriority < *overrides[j].SegmentPriority
+	})
+	for _, featureState := range overrides {
+		override := SegmentOverrideResourceData{
+			Segment: types.Int64Value(*featureState.SegmentID),
+			Enabled: types.BoolValue(featureState.Enabled),
+		}
+		if featureState.FeatureStateValue != nil {
+			fsValue := MakeFeatureStateValueFromClientFSV(featureState.FeatureStateValue)
+			override.FeatureStateValue = &fsValue
+		}
+		resourceData.SegmentOverrides = append(resourceData.SegmentOverrides, override)
+	}
+	return resourceData
+}
+
+type FeatureSegmentOverridesResourceData struct {
+	EnvironmentKey   types.String                  `tfsdk:"environment_key"`
+	Feature          types.Int64                   `tfsdk:"feature_id"`
+	SegmentOverrides []SegmentOverrideResourceData `tfsdk:"segment_overrides"`
+}
+
+// SegmentOverrideChanges are the changes required to go from the live segment overrides to the desired ones,
+// the priorities are updated separately once every override exists
+type SegmentOverrideChanges struct {
+	FeatureSegmentsToDelete []int64
+	FeatureStatesToUpdate   []*flagsmithapi.FeatureState
+	FeatureStatesToCreate   []*flagsmithapi.FeatureState
+}
+
+// ToClientSegmentOverrideChanges returns the changes required to go from the live segment overrides(as returned
+// by `GetSegmentOverrides`) to the desired ones. Overrides are matched by segment
+func (f *FeatureSegmentOverridesResourceData) ToClientSegmentOverrideChanges(liveOverrides []*flagsmithapi.FeatureState) *SegmentOverrideChanges {
+	changes := SegmentOverrideChanges{
+		FeatureSegmentsToDelete: []int64{},
+		FeatureStatesToUpdate:   []*flagsmithapi.FeatureState{},
+		FeatureStatesToCreate:   []*flagsmithapi.FeatureState{},
+	}
+	live := map[int64]*flagsmithapi.FeatureState{}
+	for _, featureState := range liveOverrides {
+		live[*featureState.Segment] = featureState
+	}
+	desired := map[int64]bool{}
+	for i, override := range f.SegmentOverrides {
+		segment := override.Segment.ValueInt64()
+		desired[segment] = true
+		featureState, ok := live[segment]
+		if !ok {
+			priority := int64(i)
+			changes.FeatureStatesToCreate = append(changes.FeatureStatesToCreate, &flagsmithapi.FeatureState{
+				Enabled:           override.Enabled.ValueBool(),
+				FeatureStateValue: override.FeatureStateValue.ToClientFSV(),
+				Feature:           f.Feature.ValueInt64(),
+				EnvironmentKey:    f.EnvironmentKey.ValueString(),
+				Segment:           &segment,
+				SegmentPriority:   &priority,
+			})
+			continue
+		}
+		if featureState.Enabled == override.Enabled.ValueBool() && featureState.FeatureStateValue != nil &&
+			MakeFeatureStateValueFromClientFSV(featureState.FeatureStateValue) == *override.FeatureStateValue {
+			continue
+		}
+		updated := *featureState
+		updated.Enabled = override.Enabled.ValueBool()
+		updated.FeatureStateValue = override.FeatureStateValue.ToClientFSV()
+		changes.FeatureStatesToUpdate = append(changes.FeatureStatesToUpdate, &updated)
+	}
+	for _, featureState := range liveOverrides {
+		if !desired[*featureState.Segment] {
+			changes.FeatureSegmentsToDelete = append(changes.FeatureSegmentsToDelete, *featureState.FeatureSegment)
+		}
+	}
+	return &changes
+}
+
+// ToClientFeatureSegmentPriorities returns the priorities of the feature segments of the overrides, i.e: their
+// position in `segment_overrides`
+func (f *FeatureSegmentOverridesResourceData) ToClientFeatureSegmentPriorities(overrides []*flagsmithapi.FeatureState) []FeatureSegmentPriority {
+	featureSegments := map[int64]int64{}
+	for _, featureState := range overrides {
+		featureSegments[*featureState.Segment] = *featureState.FeatureSegment
+	}
+	priorities := []FeatureSegmentPriority{}
+	for i, override := range f.SegmentOverrides {
+		if featureSegmentID, ok := featureSegments[override.Segment.ValueInt64()]; ok {
+			priorities = append(priorities, FeatureSegmentPriority{ID: featureSegmentID, Priority: int64(i)})
+		}
+	}
+	return priorities
+}
+
+func MakeFeatureSegmentOverridesResourceDataFromClientFS(environmentKey string, featureID int64, overrides []*flagsmithapi.FeatureState) FeatureSegmentOverridesResourceData {
+	resourceData := FeatureSegmentOverridesResourceData{
+		EnvironmentKey:   types.StringValue(environmentKey),
+		Feature:          types.Int64Value(featureID),
+		SegmentOverrides: []SegmentOverrideResourceData{},
+	}
+	for _, featureState := range overrides {
+		override := SegmentOverrideResourceData{
+			Segment: types.Int64Value(*featureState.Segment),
+			Enabled: types.BoolValue(featureState.Enabled),
+		}
+		if featureState.FeatureStateValue != nil {
+			fsValue := MakeFeatureStateValueFromClientFSV(featureState.FeatureStateValue)
+			override.FeatureStateValue = &fsValue
+		}
+		resourceData.SegmentOverrides = append(resourceData.SegmentOverrides, override)
+	}
+	return resourceData
+}
+
+type MetadataFieldResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	OrganisationID types.Int64  `tfsdk:"organisation_id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Description    types.String `tfsdk:"description"`
+}
+
+func (m *MetadataFieldResourceData) ToClientMetadataField() *MetadataField {
+	field := MetadataField{
+		Name:           m.Name.ValueString(),
+		Type:           m.Type.ValueString(),
+		Description:    m.Description.ValueString(),
+		OrganisationID: m.OrganisationID.ValueInt64(),
+	}
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		fieldID := m.ID.ValueInt64()
+		field.ID = &fieldID
+	}
+	return &field
+}
+
+func MakeMetadataFieldResourceDataFromClientMetadataField(clientField *MetadataField) MetadataFieldResourceData {
+	resourceData := MetadataFieldResourceData{
+		ID:             types.Int64Value(*clientField.ID),
+		OrganisationID: types.Int64Value(clientField.OrganisationID),
+		Name:           types.StringValue(clientField.Name),
+		Type:           types.StringValue(clientField.Type),
+	}
+	if clientField.Description != "" {
+		resourceData.Description = types.StringValue(clientField.Description)
+	}
+	return resourceData
+}
+
+type MetadataModelFieldRequirementData struct {
+	ContentType types.String `tfsdk:"content_type"`
+	ObjectID    types.Int64  `tfsdk:"object_id"`
+}
+
+type MetadataModelFieldResourceData struct {
+	ID             types.Int64                         `tfsdk:"id"`
+	OrganisationID types.Int64                         `tfsdk:"organisation_id"`
+	Field          types.Int64                         `tfsdk:"field_id"`
+	ContentType    types.String                        `tfsdk:"content_type"`
+	IsRequiredFor  []MetadataModelFieldRequirementData `tfsdk:"is_required_for"`
+}
+
+// findContentType returns the content type of the given model
+func findContentType(contentTypes []ContentType, model string) (*ContentType, error) {
+	for _, contentType := range contentTypes {
+		if contentType.Model == model {
+			return &contentType, nil
+		}
+	}
+	return nil, NotFoundError{kind: "content type", id: model}
+}
+
+// ToClientMetadataModelField converts the resource data, content types are resolved by model name
+// among contentTypes(for `content_type`) and requirementContentTypes(for `is_required_for`)
+func (m *MetadataModelFieldResourceData) ToClientMetadataModelField(contentTypes, requirementContentTypes []ContentType) (*MetadataModelField, error) {
+	contentType, err := findContentType(contentTypes, m.ContentType.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	modelField := MetadataModelField{
+		Field:         m.Field.ValueInt64(),
+		ContentType:   contentType.ID,
+		IsRequiredFor: []MetadataModelFieldRequirement{},
+	}
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		modelFieldID := m.ID.ValueInt64()
+		modelField.ID = &modelFieldID
+	}
+	for _, requirement := range m.IsRequiredFor {
+		requirementContentType, err := findContentType(requirementContentTypes, requirement.ContentType.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		modelField.IsRequiredFor = append(modelField.IsRequiredFor, MetadataModelFieldRequirement{
+			ContentType: requirementContentType.ID,
+			ObjectID:    requirement.ObjectID.ValueInt64(),
+		})
+	}
+	return &modelField, nil
+}
+
+func MakeMetadataModelFieldResourceDataFromClientMetadataModelField(clientModelField *MetadataModelField, organisationID int64, contentTypes, requirementContentTypes []ContentType) MetadataModelFieldResourceData {
+	resourceData := MetadataModelFieldResourceData{
+		ID:             types.Int64Value(*clientModelField.ID),
+		OrganisationID: types.Int64Value(organisationID),
+		Field:          types.Int64Value(clientModelField.Field),
+		ContentType:    types.StringNull(),
+	}
+	for _, contentType := range contentTypes {
+		if contentType.ID == clientModelField.ContentType {
+			resourceData.ContentType = types.StringValue(contentType.Model)
+		}
+	}
+	for _, requirement := range clientModelField.IsRequiredFor {
+		for _, contentType := range requirementContentTypes {
+			if contentType.ID == requirement.ContentType {
+				resourceData.IsRequiredFor = append(resourceData.IsRequiredFor, MetadataModelFieldRequirementData{
+					ContentType: types.StringValue(contentType.Model),
+					ObjectID:    types.Int64Value(requirement.ObjectID),
+				})
+			}
+		}
+	}
+	return resourceData
+}
+
+// validateMetadataValue checks that value is valid for a metadata field of the given type
+func validateMetadataValue(fieldType, value string) error {
+	switch fieldType {
+	case "int":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("expected an integer, got: %q", value)
+		}
+	case "bool":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("expected true or false, got: %q", value)
+		}
+	case "url":
+		parsed, err := url.ParseRequestURI(value)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("expected an http(s) URL, got: %q", value)
+		}
+	}
+	return nil
+}
+
+// makeClientMetadataValue converts the value of the metadata field `name` of a feature, a segment or
+// an environment(i.e: model) into its API representation
+func makeClientMetadataValue(name, value string, fields []ContentTypeMetadataField, model string) (*Metadata, error) {
+	for _, field := range fields {
+		if field.Field.Name != name {
+			continue
+		}
+		if field.ModelFieldID == nil {
+			return nil, fmt.Errorf("metadata field %q is not attached to %ss, see `flagsmith_metadata_model_field`", name, model)
+		}
+		if err := validateMetadataValue(field.Field.Type, value); err != nil {
+			return nil, fmt.Errorf("invalid value for metadata field %q of type %s, %s", name, field.Field.Type, err)
+		}
+		return &Metadata{ModelField: *field.ModelFieldID, FieldValue: value}, nil
+	}
+	return nil, fmt.Errorf("metadata field %q does not exist", name)
+}
+
+// makeClientMetadata converts a `metadata` map(keyed by field name) into its API representation
+func makeClientMetadata(metadata map[string]types.String, fields []ContentTypeMetadataField, model string) ([]Metadata, error) {
+	clientMetadata := []Metadata{}
+	names := []string{}
+	for name := range metadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, err := makeClientMetadataValue(name, metadata[name].ValueString(), fields, model)
+		if err != nil {
+			return nil, err
+		}
+		clientMetadata = append(clientMetadata, *value)
+	}
+	return clientMetadata, nil
+}
+
+// makeMetadataMap converts the metadata of the API into a `metadata` map(keyed by field name)
+func makeMetadataMap(clientMetadata []Metadata, fields []ContentTypeMetadataField) map[string]types.String {
+	metadata := map[string]types.String{}
+	for _, value := range clientMetadata {
+		for _, field := range fields {
+			if field.ModelFieldID != nil && *field.ModelFieldID == value.ModelField {
+				metadata[field.Field.Name] = types.StringValue(value.FieldValue)
+			}
+		}
+	}
+	return metadata
+}
+
+type AuditLogEntryData struct {
+	ID                types.Int64  `tfsdk:"id"`
+	CreatedDate       types.String `tfsdk:"created_date"`
+	Log               types.String `tfsdk:"log"`
+	Author            types.String `tfsdk:"author"`
+	ProjectID         types.Int64  `tfsdk:"project_id"`
+	EnvironmentID     types.Int64  `tfsdk:"environment_id"`
+	RelatedObjectType types.String `tfsdk:"related_object_type"`
+	RelatedObjectUUID types.String `tfsdk:"related_object_uuid"`
+}
+
+type AuditLogsDataSourceData struct {
+	ProjectID     types.Int64         `tfsdk:"project_id"`
+	EnvironmentID types.Int64         `tfsdk:"environment_id"`
+	Search        types.String        `tfsdk:"search"`
+	From          types.String        `tfsdk:"from"`
+	To            types.String        `tfsdk:"to"`
+	Entries       []AuditLogEntryData `tfsdk:"entries"`
+}
+
+func (a *AuditLogsDataSourceData) ToClientAuditLogQuery() (*AuditLogQuery, error) {
+	query := AuditLogQuery{
+		ProjectID:     a.ProjectID.ValueInt64Pointer(),
+		EnvironmentID: a.EnvironmentID.ValueInt64Pointer(),
+		Search:        a.Search.ValueString(),
+	}
+	if !a.From.IsNull() {
+		from, err := time.Parse(time.RFC3339, a.From.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		query.From = &from
+	}
+	if !a.To.IsNull() {
+		to, err := time.Parse(time.RFC3339, a.To.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		query.To = &to
+	}
+	return &query, nil
+}
+
+func MakeAuditLogEntryDataFromClientAuditLog(clientLog *AuditLog) AuditLogEntryData {
+	entry := AuditLogEntryData{
+		ID:                types.Int64Value(clientLog.ID),
+		CreatedDate:       types.StringValue(clientLog.CreatedDate),
+		Log:               types.StringValue(clientLog.Log),
+		Author:            types.StringNull(),
+		ProjectID:         types.Int64Null(),
+		EnvironmentID:     types.Int64Null(),
+		RelatedObjectType: types.StringPointerValue(clientLog.RelatedObjectType),
+		RelatedObjectUUID: types.StringPointerValue(clientLog.RelatedObjectUUID),
+	}
+	// System events have no author
+	if clientLog.Author != nil {
+		entry.Author = types.StringValue(clientLog.Author.Email)
+	}
+	if clientLog.Project != nil {
+		entry.ProjectID = types.Int64Value(clientLog.Project.ID)
+	}
+	if clientLog.Environment != nil {
+		entry.EnvironmentID = types.Int64Value(clientLog.Environment.ID)
+	}
+	return entry
+}
+
+type FeatureExportResourceData struct {
+	ID             types.Int64   `tfsdk:"id"`
+	EnvironmentKey types.String  `tfsdk:"environment_key"`
+	TagIDs         []types.Int64 `tfsdk:"tag_ids"`
+	Name           types.String  `tfsdk:"name"`
+	Status         types.String  `tfsdk:"status"`
+	CreatedAt      types.String  `tfsdk:"created_at"`
+	Content        types.String  `tfsdk:"content"`
+}
+
+func (f *FeatureExportResourceData) ToClientTagIDs() []int64 {
+	tagIDs := []int64{}
+	for _, tagID := range f.TagIDs {
+		tagIDs = append(tagIDs, tagID.ValueInt64())
+	}
+	return tagIDs
+}
+
+// MakeFeatureExportResourceDataFromClientFeatureExport returns the resource data of the feature export, the
+// attributes that are not returned by the API(i.e: environment_key, tag_ids and content) are left to the caller
+func MakeFeatureExportResourceDataFromClientFeatureExport(clientExport *FeatureExport) FeatureExportResourceData {
+	return FeatureExportResourceData{
+		ID:        types.Int64Value(clientExport.ID),
+		Name:      types.StringValue(clientExport.Name),
+		Status:    types.StringValue(clientExport.Status),
+		CreatedAt: types.StringValue(clientExport.CreatedAt),
+	}
+}
+
+type FeatureImportResourceData struct {
+	ID             types.Int64  `tfsdk:"id"`
+	EnvironmentKey types.String `tfsdk:"environment_key"`
+	Strategy       types.String `tfsdk:"strategy"`
+	Content        types.String `tfsdk:"content"`
+	Status         types.String `tfsdk:"status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// MakeFeatureImportResourceDataFromClientFeatureImport returns the resource data of the feature import, the
+// attributes that are not returned by the API(i.e: environment_key and content) are left to the caller
+func MakeFeatureImportResourceDataFromClientFeatureImport(clientImport *FeatureImport) FeatureImportResourceData {
+	return FeatureImportResourceData{
+		ID:        types.Int64Value(clientImport.ID),
+		Strategy:  types.StringValue(clientImport.Strategy),
+		Status:    types.StringValue(clientImport.Status),
+		CreatedAt: types.StringValue(clientImport.CreatedAt),
+	}
+}
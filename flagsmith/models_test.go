@@ -1,6 +1,7 @@
 package flagsmith
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -194,3 +195,899 @@ func TestFeatureStateResourceDataToClientFS(t *testing.T) {
 	assert.Equal(t, nilBool, clientFS.FeatureStateValue.BooleanValue)
 
 }
+
+func TestTraitValueToClientTrait(t *testing.T) {
+	// Given
+	traitValue := TraitValue{
+		Type:         types.StringValue("float"),
+		StringValue:  types.StringNull(),
+		IntegerValue: types.Int64Null(),
+		FloatValue:   types.Float64Value(1.5),
+		BooleanValue: types.BoolNull(),
+	}
+	// When
+	clientTrait := traitValue.ToClientTrait("score")
+
+	// Then
+	var nilString *string
+	var nilInt *int
+	var nilBool *bool
+
+	assert.Equal(t, "score", clientTrait.TraitKey)
+	assert.Equal(t, "float", clientTrait.ValueType)
+	assert.Equal(t, 1.5, *clientTrait.FloatValue)
+	assert.Equal(t, nilString, clientTrait.StringValue)
+	assert.Equal(t, nilInt, clientTrait.IntegerValue)
+	assert.Equal(t, nilBool, clientTrait.BooleanValue)
+}
+
+func TestMakeTraitValueFromClientTrait(t *testing.T) {
+	// Given
+	intValue := 42
+	clientTrait := flagsmithapi.Trait{
+		TraitKey:     "age",
+		ValueType:    "int",
+		IntegerValue: &intValue,
+	}
+	// When
+	traitValue := MakeTraitValueFromClientTrait(&clientTrait)
+
+	// Then
+	assert.Equal(t, "int", traitValue.Type.ValueString())
+	assert.Equal(t, int64(42), traitValue.IntegerValue.ValueInt64())
+	assert.Equal(t, true, traitValue.StringValue.IsNull())
+	assert.Equal(t, true, traitValue.FloatValue.IsNull())
+	assert.Equal(t, true, traitValue.BooleanValue.IsNull())
+}
+
+func TestMakeTraitValueFromClientTraitWithoutValue(t *testing.T) {
+	for _, valueType := range []string{"int", "float", "bool"} {
+		// Given
+		clientTrait := flagsmithapi.Trait{TraitKey: "age", ValueType: valueType}
+
+		// When
+		traitValue := MakeTraitValueFromClientTrait(&clientTrait)
+
+		// Then the value is null instead of panicking
+		assert.Equal(t, valueType, traitValue.Type.ValueString())
+		assert.Equal(t, true, traitValue.IntegerValue.IsNull())
+		assert.Equal(t, true, traitValue.FloatValue.IsNull())
+		assert.Equal(t, true, traitValue.BooleanValue.IsNull())
+	}
+}
+
+func TestTraitValueValidateType(t *testing.T) {
+	// Given
+	traitValue := TraitValue{
+		Type:         types.StringValue("int"),
+		StringValue:  types.StringValue("42"),
+		IntegerValue: types.Int64Null(),
+		FloatValue:   types.Float64Null(),
+		BooleanValue: types.BoolNull(),
+	}
+
+	// Then the value must match the type
+	assert.EqualError(t, traitValue.ValidateType(), `type is "int", hence integer_value must be set`)
+
+	// When
+	traitValue.StringValue = types.StringNull()
+	traitValue.IntegerValue = types.Int64Value(42)
+
+	// Then
+	assert.NoError(t, traitValue.ValidateType())
+
+	// and unknown types are not checked
+	traitValue.Type = types.StringUnknown()
+	traitValue.IntegerValue = types.Int64Null()
+	assert.NoError(t, traitValue.ValidateType())
+}
+
+func TestEdgeTraitRoundTrip(t *testing.T) {
+	traitValues := []TraitValue{
+		{Type: types.StringValue("unicode"), StringValue: types.StringValue("mobile"), IntegerValue: types.Int64Null(), FloatValue: types.Float64Null(), BooleanValue: types.BoolNull()},
+		{Type: types.StringValue("int"), StringValue: types.StringNull(), IntegerValue: types.Int64Value(7), FloatValue: types.Float64Null(), BooleanValue: types.BoolNull()},
+		{Type: types.StringValue("float"), StringValue: types.StringNull(), IntegerValue: types.Int64Null(), FloatValue: types.Float64Value(2), BooleanValue: types.BoolNull()},
+		{Type: types.StringValue("float"), StringValue: types.StringNull(), IntegerValue: types.Int64Null(), FloatValue: types.Float64Value(0.25), BooleanValue: types.BoolNull()},
+		{Type: types.StringValue("bool"), StringValue: types.StringNull(), IntegerValue: types.Int64Null(), FloatValue: types.Float64Null(), BooleanValue: types.BoolValue(false)},
+	}
+	for _, traitValue := range traitValues {
+		// When
+		edgeTrait := traitValue.ToEdgeTrait("key")
+		readTraitValue := MakeTraitValueFromEdgeTrait(edgeTrait)
+
+		// Then
+		assert.Equal(t, "key", edgeTrait.TraitKey)
+		assert.True(t, traitValue.Equal(readTraitValue), "%s did not survive the round trip", edgeTrait.TraitValue)
+	}
+}
+
+func TestMakeIdentityFeatureStateResourceDataFromEdgeFS(t *testing.T) {
+	// Given
+	var clientFS IdentityFeatureState
+	err := json.Unmarshal([]byte(`{"featurestate_uuid": "some-uuid", "feature": 1, "enabled": true, "feature_state_value": 10}`), &clientFS)
+	assert.NoError(t, err)
+
+	// When
+	resourceData := MakeIdentityFeatureStateResourceDataFromClientFS(&clientFS)
+
+	// Then
+	assert.Equal(t, true, resourceData.ID.IsNull())
+	assert.Equal(t, "some-uuid", resourceData.UUID.ValueString())
+	assert.Equal(t, int64(1), resourceData.Feature.ValueInt64())
+	assert.Equal(t, true, resourceData.Enabled.ValueBool())
+	assert.Equal(t, "int", resourceData.FeatureStateValue.Type.ValueString())
+	assert.Equal(t, int64(10), resourceData.FeatureStateValue.IntegerValue.ValueInt64())
+}
+
+func TestIdentityFeatureStateResourceDataToClientFS(t *testing.T) {
+	// Given
+	resourceData := IdentityFeatureStateResourceData{
+		ID:      types.Int64Value(1),
+		Feature: types.Int64Value(2),
+		Enabled: types.BoolValue(false),
+		FeatureStateValue: &FeatureStateValue{
+			Type:         types.StringValue("unicode"),
+			StringValue:  types.StringValue("vip"),
+			IntegerValue: types.Int64Null(),
+			BooleanValue: types.BoolNull(),
+		},
+	}
+
+	// When
+	clientFS := resourceData.ToClientIdentityFS()
+	body, err := json.Marshal(clientFS)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), clientFS.ID)
+	assert.JSONEq(t, `{"feature": 2, "enabled": false, "feature_state_value": "vip"}`, string(body))
+}
+
+func TestMakeProjectPermissionResourceDataFromClientPermission(t *testing.T) {
+	// Given - the list endpoint returns the user as a nested object
+	var clientPermission ObjectPermission
+	err := json.Unmarshal([]byte(`{"id": 1, "user": {"id": 10, "email": "user@example.com"}, "admin": false, "permissions": ["VIEW_PROJECT", "CREATE_FEATURE"]}`), &clientPermission)
+	assert.NoError(t, err)
+
+	// When
+	resourceData := MakeProjectPermissionResourceDataFromClientPermission(&clientPermission, "project-uuid", 2)
+
+	// Then
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, "project-uuid", resourceData.ProjectUUID.ValueString())
+	assert.Equal(t, int64(2), resourceData.ProjectID.ValueInt64())
+	assert.Equal(t, int64(10), resourceData.UserID.ValueInt64())
+	assert.Equal(t, true, resourceData.GroupID.IsNull())
+	assert.Equal(t, false, resourceData.Admin.ValueBool())
+	assert.Equal(t, []types.String{types.StringValue("CREATE_FEATURE"), types.StringValue("VIEW_PROJECT")}, resourceData.Permissions)
+}
+
+func TestProjectPermissionResourceDataToClientPermission(t *testing.T) {
+	// Given
+	resourceData := ProjectPermissionResourceData{
+		ID:          types.Int64Unknown(),
+		ProjectUUID: types.StringValue("project-uuid"),
+		ProjectID:   types.Int64Unknown(),
+		UserID:      types.Int64Null(),
+		GroupID:     types.Int64Value(3),
+		Admin:       types.BoolValue(true),
+		Permissions: []types.String{},
+	}
+
+	// When
+	clientPermission := resourceData.ToClientPermission()
+	body, err := json.Marshal(clientPermission)
+
+	// Then
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"group": 3, "admin": true, "permissions": []}`, string(body))
+}
+
+func TestMakeEnvironmentPermissionResourceDataFromClientPermission(t *testing.T) {
+	// Given - the create endpoint returns the group as an ID
+	var clientPermission ObjectPermission
+	err := json.Unmarshal([]byte(`{"id": 1, "group": 3, "admin": true, "permissions": null}`), &clientPermission)
+	assert.NoError(t, err)
+
+	// When
+	resourceData := MakeEnvironmentPermissionResourceDataFromClientPermission(&clientPermission, "env-key", "env-uuid")
+
+	// Then
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, "env-key", resourceData.EnvironmentKey.ValueString())
+	assert.Equal(t, "env-uuid", resourceData.EnvironmentUUID.ValueString())
+	assert.Equal(t, true, resourceData.UserID.IsNull())
+	assert.Equal(t, int64(3), resourceData.GroupID.ValueInt64())
+	assert.Equal(t, true, resourceData.Admin.ValueBool())
+	assert.Equal(t, []types.String{}, resourceData.Permissions)
+}
+
+func TestMakeRoleAssignmentResourceDataFromClientRoleAssignment(t *testing.T) {
+	// Given - the role is implied by the url and not part of the response
+	clientAssignment := RoleAssignment{Role: 5}
+	err := json.Unmarshal([]byte(`{"id": 1, "master_api_key": {"id": "key-id", "name": "ci"}}`), &clientAssignment)
+	assert.NoError(t, err)
+
+	// When
+	resourceData := MakeRoleAssignmentResourceDataFromClientRoleAssignment(&clientAssignment, 2)
+
+	// Then
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, int64(2), resourceData.OrganisationID.ValueInt64())
+	assert.Equal(t, int64(5), resourceData.RoleID.ValueInt64())
+	assert.Equal(t, true, resourceData.UserID.IsNull())
+	assert.Equal(t, true, resourceData.GroupID.IsNull())
+	assert.Equal(t, "key-id", resourceData.MasterAPIKeyID.ValueString())
+}
+
+func TestRoleProjectPermissionResourceDataToClientRolePermission(t *testing.T) {
+	// Given
+	resourceData := RoleProjectPermissionResourceData{
+		ID:             types.Int64Unknown(),
+		OrganisationID: types.Int64Value(1),
+		RoleID:         types.Int64Value(2),
+		ProjectUUID:    types.StringValue("project-uuid"),
+		ProjectID:      types.Int64Value(3),
+		Admin:          types.BoolValue(false),
+		Permissions:    []types.String{types.StringValue("CREATE_FEATURE")},
+	}
+
+	// When
+	clientPermission := resourceData.ToClientRolePermission()
+	body, err := json.Marshal(clientPermission)
+
+	// Then
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"role": 2, "project": 3, "admin": false, "permissions": ["CREATE_FEATURE"]}`, string(body))
+}
+
+func TestMakeServerSideEnvironmentKeyResourceDataFromClientKey(t *testing.T) {
+	// Given - the API returns `expires_at` in a different(but equivalent) format
+	var clientKey flagsmithapi.ServerSideEnvKey
+	err := json.Unmarshal([]byte(`{"id": 1, "active": true, "name": "backend", "key": "", "expires_at": "2099-01-01T02:00:00+02:00"}`), &clientKey)
+	assert.NoError(t, err)
+	data := ServerSideEnvironmentKeyResourceData{
+		ExpiresAt: types.StringValue("2099-01-01T00:00:00Z"),
+		Key:       types.StringValue("ser.secret"),
+		Keepers:   types.MapNull(types.StringType),
+	}
+
+	// When
+	resourceData := MakeServerSideEnvironmentKeyResourceDataFromClientKey(&clientKey, "env-key", &data)
+
+	// Then
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, "env-key", resourceData.EnvironmentKey.ValueString())
+	assert.Equal(t, "backend", resourceData.Name.ValueString())
+	assert.Equal(t, "2099-01-01T00:00:00Z", resourceData.ExpiresAt.ValueString())
+	assert.Equal(t, "ser.secret", resourceData.Key.ValueString())
+	assert.Equal(t, true, resourceData.CreatedAt.IsNull())
+}
+
+func TestMakeMasterAPIKeyResourceDataFromClientMasterAPIKey(t *testing.T) {
+	// Given - the key is not part of the response after creation
+	var clientKey MasterAPIKey
+	err := json.Unmarshal([]byte(`{"id": "key-id", "prefix": "abcd1234", "name": "ci", "expiry_date": "2099-01-01T00:00:00.000000Z", "is_admin": false, "revoked": false}`), &clientKey)
+	assert.NoError(t, err)
+	data := MasterAPIKeyResourceData{
+		OrganisationID: types.Int64Value(1),
+		ExpiryDate:     types.StringValue("2099-01-01T00:00:00Z"),
+		Roles:          &[]types.Int64{types.Int64Value(2)},
+		Key:            types.StringValue("abcd1234.secret"),
+	}
+
+	// When
+	resourceData := MakeMasterAPIKeyResourceDataFromClientMasterAPIKey(&clientKey, &data)
+
+	// Then
+	assert.Equal(t, "key-id", resourceData.ID.ValueString())
+	assert.Equal(t, "abcd1234", resourceData.Prefix.ValueString())
+	assert.Equal(t, int64(1), resourceData.OrganisationID.ValueInt64())
+	assert.Equal(t, "2099-01-01T00:00:00Z", resourceData.ExpiryDate.ValueString())
+	assert.Equal(t, false, resourceData.IsAdmin.ValueBool())
+	assert.Equal(t, &[]types.Int64{types.Int64Value(2)}, resourceData.Roles)
+	assert.Equal(t, "abcd1234.secret", resourceData.Key.ValueString())
+}
+
+func TestMakeEnvironmentIntegrationResourceDataFromClientEnvironmentIntegration(t *testing.T) {
+	// Given
+	var clientIntegration EnvironmentIntegration
+	err := json.Unmarshal([]byte(`{"id": 1, "api_key": "segment-key", "base_url": "https://events.eu1.segmentapis.com"}`), &clientIntegration)
+	assert.NoError(t, err)
+
+	// When
+	resourceData := MakeEnvironmentIntegrationResourceDataFromClientEnvironmentIntegration(&clientIntegration, "env-key", "segment")
+
+	// Then
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, "env-key", resourceData.EnvironmentKey.ValueString())
+	assert.Equal(t, "segment", resourceData.Type.ValueString())
+	assert.Equal(t, "segment-key", resourceData.Segment.APIKey.ValueString())
+	assert.Equal(t, "https://events.eu1.segmentapis.com", resourceData.Segment.BaseURL.ValueString())
+	assert.Nil(t, resourceData.Amplitude)
+}
+
+func TestEnvironmentIntegrationResourceDataToClientEnvironmentIntegrationWithoutBaseURL(t *testing.T) {
+	// Given - heap does not support a base URL
+	resourceData := EnvironmentIntegrationResourceData{
+		ID:             types.Int64Value(1),
+		EnvironmentKey: types.StringValue("env-key"),
+		Type:           types.StringValue("heap"),
+		Heap:           &HeapIntegrationConfig{APIKey: types.StringValue("heap-key")},
+	}
+
+	// When
+	clientIntegration := resourceData.ToClientEnvironmentIntegration()
+
+	// Then
+	assert.Equal(t, int64(1), *clientIntegration.ID)
+	assert.Equal(t, "heap-key", clientIntegration.APIKey)
+	assert.Nil(t, clientIntegration.BaseURL)
+}
+
+func TestProjectIntegrationResourceDataToClientProjectIntegration(t *testing.T) {
+	// Given
+	data := ProjectIntegrationResourceData{
+		ID:   types.Int64Value(1),
+		Type: types.StringValue("dynatrace"),
+		Dynatrace: &DynatraceIntegrationConfig{
+			APIKey:         types.StringValue("dt-token"),
+			BaseURL:        types.StringValue("https://abc.live.dynatrace.com/"),
+			EntitySelector: types.StringNull(),
+		},
+	}
+
+	// When
+	clientIntegration := data.ToClientProjectIntegration()
+
+	// Then - the selector is sent empty to clear it
+	assert.Equal(t, int64(1), *clientIntegration.ID)
+	assert.Equal(t, "dt-token", clientIntegration.APIKey)
+	assert.Equal(t, "https://abc.live.dynatrace.com/", clientIntegration.BaseURL)
+	assert.Equal(t, "", *clientIntegration.EntitySelector)
+	assert.Nil(t, clientIntegration.AppID)
+	assert.Nil(t, clientIntegration.UseCustomSource)
+}
+
+func TestMakeProjectIntegrationResourceDataFromClientProjectIntegration(t *testing.T) {
+	// Given
+	var clientIntegration ProjectIntegration
+	err := json.Unmarshal([]byte(`{"id": 1, "api_key": "nr-key", "base_url": "https://api.newrelic.com/", "app_id": "42"}`), &clientIntegration)
+	assert.NoError(t, err)
+
+	// When
+	resourceData := MakeProjectIntegrationResourceDataFromClientProjectIntegration(&clientIntegration, "project-uuid", 2, "new_relic")
+
+	// Then
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, "project-uuid", resourceData.ProjectUUID.ValueString())
+	assert.Equal(t, int64(2), resourceData.ProjectID.ValueInt64())
+	assert.Equal(t, "nr-key", resourceData.NewRelic.APIKey.ValueString())
+	assert.Equal(t, "42", resourceData.NewRelic.AppID.ValueString())
+	assert.Nil(t, resourceData.Datadog)
+}
+
+func TestFeatureStateResourceDataToClientChangeRequest(t *testing.T) {
+	// Given
+	featureSegmentID := int64(3)
+	data := FeatureStateResourceData{
+		ID:             types.Int64Value(1),
+		Enabled:        types.BoolValue(true),
+		Feature:        types.Int64Value(2),
+		Environment:    types.Int64Value(4),
+		FeatureSegment: types.Int64Value(featureSegmentID),
+		FeatureStateValue: &FeatureStateValue{
+			Type:         types.StringValue("unicode"),
+			StringValue:  types.StringValue("new_value"),
+			IntegerValue: types.Int64Null(),
+			BooleanValue: types.BoolNull(),
+		},
+		ChangeRequest: &FeatureStateChangeRequest{
+			Title:            types.StringValue("Release"),
+			Description:      types.StringNull(),
+			ApproverUserIDs:  []types.Int64{types.Int64Value(10)},
+			ApproverGroupIDs: []types.Int64{types.Int64Value(20)},
+		},
+	}
+
+	// When
+	changeRequest := data.ToClientChangeRequest()
+
+	// Then
+	assert.Equal(t, "Release", changeRequest.Title)
+	assert.Equal(t, "", changeRequest.Description)
+	assert.Equal(t, []ChangeRequestApproval{{User: 10}}, changeRequest.Approvals)
+	assert.Equal(t, []ChangeRequestGroupAssignment{{Group: 20}}, changeRequest.GroupAssignments)
+	assert.Equal(t, 1, len(changeRequest.FeatureStates))
+	assert.Equal(t, int64(2), changeRequest.FeatureStates[0].Feature)
+	assert.Equal(t, true, changeRequest.FeatureStates[0].Enabled)
+	assert.Equal(t, "new_value", *changeRequest.FeatureStates[0].FeatureStateValue.StringValue)
+	assert.Equal(t, &featureSegmentID, changeRequest.FeatureStates[0].FeatureSegment)
+}
+
+func TestChangeRequestApprovalCount(t *testing.T) {
+	// Given - one of the assigned approvers has not approved yet
+	var changeRequest ChangeRequest
+	err := json.Unmarshal([]byte(`{"id": 1, "title": "Release", "approvals": [{"user": 10, "approved_at": "2024-01-02T15:04:05Z"}, {"user": 11, "approved_at": null}], "committed_at": null}`), &changeRequest)
+	assert.NoError(t, err)
+
+	// When
+	count := changeRequest.ApprovalCount()
+
+	// Then
+	assert.Equal(t, int64(1), count)
+	assert.Nil(t, changeRequest.CommittedAt)
+}
+
+func TestFeatureStateResourceDataToClientChangeRequestScheduled(t *testing.T) {
+	// Given - a scheduled change without a change request block
+	data := FeatureStateResourceData{
+		Enabled:  types.BoolValue(true),
+		Feature:  types.Int64Value(2),
+		LiveFrom: types.StringValue("2030-01-07T09:00:00Z"),
+		FeatureStateValue: &FeatureStateValue{
+			Type:         types.StringValue("bool"),
+			StringValue:  types.StringNull(),
+			IntegerValue: types.Int64Null(),
+			BooleanValue: types.BoolValue(true),
+		},
+	}
+
+	// When
+	changeRequest := data.ToClientChangeRequest()
+
+	// Then
+	assert.Equal(t, "Scheduled change of feature 2", changeRequest.Title)
+	assert.Equal(t, "2030-01-07T09:00:00Z", *changeRequest.FeatureStates[0].LiveFrom)
+	assert.Empty(t, changeRequest.Approvals)
+	assert.Nil(t, changeRequest.FeatureStates[0].FeatureSegment)
+}
+
+func TestEnvironmentFeatureVersionResourceDataToClientFeatureVersionChanges(t *testing.T) {
+	// Given - segment 2 is overridden and moved first, segment 3 is not overridden anymore
+	var liveFeatureStates []FeatureVersionFeatureState
+	err := json.Unmarshal([]byte(`[
+		{"id": 1, "enabled": false, "feature_state_value": {"type": "unicode", "string_value": "old"}, "feature_segment": null},
+		{"id": 2, "enabled": true, "feature_state_value": {"type": "unicode", "string_value": "beta"}, "feature_segment": {"segment": 3, "priority": 0}},
+		{"id": 3, "enabled": true, "feature_state_value": {"type": "unicode", "string_value": "old"}, "feature_segment": {"segment": 2, "priority": 1}}
+	]`), &liveFeatureStates)
+	assert.NoError(t, err)
+	value := func(value string) *FeatureStateValue {
+		return &FeatureStateValue{
+			Type:         types.StringValue("unicode"),
+			StringValue:  types.StringValue(value),
+			IntegerValue: types.Int64Null(),
+			BooleanValue: types.BoolNull(),
+		}
+	}
+	data := EnvironmentFeatureVersionResourceData{
+		Enabled:           types.BoolValue(true),
+		FeatureStateValue: value("new"),
+		SegmentOverrides: []SegmentOverrideResourceData{
+			{Segment: types.Int64Value(2), Enabled: types.BoolValue(false), FeatureStateValue: value("two")},
+			{Segment: types.Int64Value(4), Enabled: types.BoolValue(true), FeatureStateValue: value("four")},
+		},
+	}
+
+	// When
+	changes := data.ToClientFeatureVersionChanges(liveFeatureStates)
+
+	// Then
+	assert.True(t, changes.PublishImmediately)
+	assert.Equal(t, 2, len(changes.FeatureStatesToUpdate))
+	assert.Nil(t, changes.FeatureStatesToUpdate[0].SegmentID)
+	assert.Equal(t, true, changes.FeatureStatesToUpdate[0].Enabled)
+	assert.Equal(t, "new", *changes.FeatureStatesToUpdate[0].FeatureStateValue.StringValue)
+	assert.Equal(t, int64(2), *changes.FeatureStatesToUpdate[1].SegmentID)
+	assert.Equal(t, int64(0), *changes.FeatureStatesToUpdate[1].SegmentPriority)
+	assert.Equal(t, 1, len(changes.FeatureStatesToCreate))
+	assert.Equal(t, int64(4), *changes.FeatureStatesToCreate[0].SegmentID)
+	assert.Equal(t, int64(1), *changes.FeatureStatesToCreate[0].SegmentPriority)
+	assert.Equal(t, []int64{3}, changes.SegmentIDsToDeleteOverrides)
+
+	body, err := json.Marshal(&changes.FeatureStatesToCreate[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"enabled": true, "feature_state_value": {"type": "unicode", "string_value": "four", "integer_value": null, "boolean_value": null}, "feature_segment": {"segment": 4, "priority": 1}}`, string(body))
+}
+
+func TestMakeEnvironmentFeatureVersionResourceDataFromClientVersions(t *testing.T) {
+	// Given
+	var liveFeatureStates []FeatureVersionFeatureState
+	err := json.Unmarshal([]byte(`[
+		{"id": 2, "enabled": true, "feature_state_value": {"type": "int", "integer_value": 2}, "feature_segment": {"segment": 3, "priority": 1}},
+		{"id": 1, "enabled": true, "feature_state_value": {"type": "int", "integer_value": 0}, "feature_segment": null},
+		{"id": 3, "enabled": false, "feature_state_value": {"type": "int", "integer_value": 1}, "feature_segment": {"segment": 5, "priority": 0}}
+	]`), &liveFeatureStates)
+	assert.NoError(t, err)
+	versions := []FeatureVersion{{UUID: "first"}, {UUID: "second"}}
+
+	// When
+	data := MakeEnvironmentFeatureVersionResourceDataFromClientVersions(versions, liveFeatureStates, "env_key", 10, 20)
+
+	// Then
+	assert.Equal(t, "second", data.UUID.ValueString())
+	assert.Equal(t, "env_key", data.EnvironmentKey.ValueString())
+	assert.Equal(t, int64(10), data.Environment.ValueInt64())
+	assert.Equal(t, int64(20), data.Feature.ValueInt64())
+	assert.Equal(t, true, data.Enabled.ValueBool())
+	assert.Equal(t, int64(0), data.FeatureStateValue.IntegerValue.ValueInt64())
+	assert.Equal(t, 2, len(data.SegmentOverrides))
+	assert.Equal(t, int64(5), data.SegmentOverrides[0].Segment.ValueInt64())
+	assert.Equal(t, false, data.SegmentOverrides[0].Enabled.ValueBool())
+	assert.Equal(t, int64(3), data.SegmentOverrides[1].Segment.ValueInt64())
+	assert.Equal(t, int64(2), data.SegmentOverrides[1].FeatureStateValue.IntegerValue.ValueInt64())
+	assert.Equal(t, 2, len(data.VersionHistory.Elements()))
+	assert.Equal(t, types.StringValue("first"), data.VersionHistory.Elements()[0])
+}
+
+func TestValidateMetadataValue(t *testing.T) {
+	assert.NoError(t, validateMetadataValue("int", "42"))
+	assert.Error(t, validateMetadataValue("int", "forty-two"))
+	assert.NoError(t, validateMetadataValue("bool", "false"))
+	assert.Error(t, validateMetadataValue("bool", "yes"))
+	assert.NoError(t, validateMetadataValue("url", "https://example.com/path"))
+	assert.Error(t, validateMetadataValue("url", "example.com"))
+	assert.NoError(t, validateMetadataValue("str", "anything"))
+	assert.NoError(t, validateMetadataValue("multiline_str", "any\nthing"))
+}
+
+func TestMakeClientMetadata(t *testing.T) {
+	// Given
+	attachedID := int64(10)
+	otherAttachedID := int64(11)
+	fields := []ContentTypeMetadataField{
+		{Field: MetadataField{Name: "owner", Type: "str"}, ModelFieldID: &attachedID},
+		{Field: MetadataField{Name: "ticket", Type: "int"}, ModelFieldID: &otherAttachedID},
+		{Field: MetadataField{Name: "unattached", Type: "str"}},
+	}
+	metadata := map[string]types.String{
+		"ticket": types.StringValue("123"),
+		"owner":  types.StringValue("team-a"),
+	}
+
+	// When
+	clientMetadata, err := makeClientMetadata(metadata, fields, "feature")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []Metadata{{ModelField: 10, FieldValue: "team-a"}, {ModelField: 11, FieldValue: "123"}}, clientMetadata)
+	assert.Equal(t, metadata, makeMetadataMap(clientMetadata, fields))
+
+	// and invalid metadata is rejected
+	_, err = makeClientMetadata(map[string]types.String{"ticket": types.StringValue("abc")}, fields, "feature")
+	assert.ErrorContains(t, err, `invalid value for metadata field "ticket" of type int`)
+	_, err = makeClientMetadata(map[string]types.String{"unattached": types.StringValue("abc")}, fields, "feature")
+	assert.ErrorContains(t, err, `metadata field "unattached" is not attached to features`)
+	_, err = makeClientMetadata(map[string]types.String{"missing": types.StringValue("abc")}, fields, "feature")
+	assert.ErrorContains(t, err, `metadata field "missing" does not exist`)
+}
+
+func TestMetadataModelFieldResourceDataToClientMetadataModelField(t *testing.T) {
+	// Given
+	contentTypes := []ContentType{{ID: 1, Model: "feature"}, {ID: 2, Model: "segment"}}
+	requirementContentTypes := []ContentType{{ID: 3, Model: "organisation"}, {ID: 4, Model: "project"}}
+	data := MetadataModelFieldResourceData{
+		ID:             types.Int64Unknown(),
+		OrganisationID: types.Int64Value(1),
+		Field:          types.Int64Value(5),
+		ContentType:    types.StringValue("segment"),
+		IsRequiredFor: []MetadataModelFieldRequirementData{
+			{ContentType: types.StringValue("project"), ObjectID: types.Int64Value(7)},
+		},
+	}
+
+	// When
+	modelField, err := data.ToClientMetadataModelField(contentTypes, requirementContentTypes)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Nil(t, modelField.ID)
+	assert.Equal(t, int64(5), modelField.Field)
+	assert.Equal(t, int64(2), modelField.ContentType)
+	assert.Equal(t, []MetadataModelFieldRequirement{{ContentType: 4, ObjectID: 7}}, modelField.IsRequiredFor)
+
+	// and the conversion round trips
+	modelFieldID := int64(9)
+	modelField.ID = &modelFieldID
+	resourceData := MakeMetadataModelFieldResourceDataFromClientMetadataModelField(modelField, 1, contentTypes, requirementContentTypes)
+	data.ID = types.Int64Value(9)
+	assert.Equal(t, data, resourceData)
+}
+
+func TestManagedOrganisationResourceDataToClientOrganisation(t *testing.T) {
+	// Given
+	email := "old@example.com"
+	current := Organisation{ID: 1, UUID: "org-uuid", Name: "Org", Force2FA: true, PersistTraitData: true, WebhookNotificationEmail: &email}
+	data := ManagedOrganisationResourceData{
+		UUID:                         types.StringValue("org-uuid"),
+		Force2FA:                     types.BoolUnknown(),
+		PersistTraitData:             types.BoolValue(false),
+		RestrictProjectCreateToAdmin: types.BoolValue(true),
+		WebhookNotificationEmail:     types.StringNull(),
+		DeleteOnDestroy:              types.BoolValue(false),
+	}
+
+	// When
+	organisation := data.ToClientOrganisation(&current)
+
+	// Then
+	assert.Equal(t, "Org", organisation.Name)
+	assert.Equal(t, true, organisation.Force2FA)
+	assert.Equal(t, false, organisation.PersistTraitData)
+	assert.Equal(t, true, organisation.RestrictProjectCreateToAdmin)
+	assert.Nil(t, organisation.WebhookNotificationEmail)
+	// and the current organisation is left untouched
+	assert.Equal(t, "old@example.com", *current.WebhookNotificationEmail)
+
+	// and the conversion back sets the computed settings
+	resourceData := MakeManagedOrganisationResourceDataFromClientOrganisation(organisation, data.DeleteOnDestroy)
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, types.BoolValue(true), resourceData.Force2FA)
+	assert.Equal(t, types.StringNull(), resourceData.WebhookNotificationEmail)
+	assert.Equal(t, types.BoolValue(false), resourceData.DeleteOnDestroy)
+}
+
+func TestOrganisationInviteResourceDataToClientOrganisationInvite(t *testing.T) {
+	// Given
+	data := OrganisationInviteResourceData{
+		ID:             types.Int64Unknown(),
+		OrganisationID: types.Int64Value(1),
+		Email:          types.StringValue("user@example.com"),
+		Role:           types.StringValue("ADMIN"),
+		Groups:         []types.Int64{types.Int64Value(2), types.Int64Value(3)},
+	}
+
+	// When
+	invite := data.ToClientOrganisationInvite()
+
+	// Then
+	assert.Nil(t, invite.ID)
+	assert.Equal(t, int64(1), invite.OrganisationID)
+	assert.Equal(t, "user@example.com", invite.Email)
+	assert.Equal(t, "ADMIN", invite.Role)
+	assert.Equal(t, []int64{2, 3}, invite.PermissionGroups)
+
+	// and the conversion round trips
+	inviteID := int64(4)
+	invite.ID = &inviteID
+	data.ID = types.Int64Value(4)
+	assert.Equal(t, data, MakeOrganisationInviteResourceDataFromClientOrganisationInvite(invite))
+}
+
+func TestMakeOrganisationInviteResourceDataFromClientOrganisationInviteWithoutID(t *testing.T) {
+	// Given an invite whose ID could not be looked up
+	invite := OrganisationInvite{
+		Email:            "user@example.com",
+		Role:             "USER",
+		PermissionGroups: []int64{},
+		OrganisationID:   1,
+	}
+
+	// When
+	resourceData := MakeOrganisationInviteResourceDataFromClientOrganisationInvite(&invite)
+
+	// Then
+	assert.Equal(t, types.Int64Null(), resourceData.ID)
+	assert.Equal(t, types.StringValue("user@example.com"), resourceData.Email)
+}
+
+func TestMakeAuditLogEntryDataFromClientAuditLog(t *testing.T) {
+	// Given
+	var clientLogs []AuditLog
+	err := json.Unmarshal([]byte(`[
+		{"id": 2, "created_date": "2024-01-02T15:04:05.123456Z", "log": "Flag state updated for feature: my_feature",
+		 "author": {"id": 5, "email": "user@example.com"}, "project": {"id": 10, "name": "Project"},
+		 "environment": {"id": 20, "name": "Production"}, "related_object_type": "FEATURE_STATE", "related_object_uuid": "fs-uuid"},
+		{"id": 1, "created_date": "2024-01-01T10:00:00Z", "log": "New Project created", "author": null,
+		 "project": null, "environment": null, "related_object_type": null, "related_object_uuid": null}
+	]`), &clientLogs)
+	assert.NoError(t, err)
+
+	// When
+	entry := MakeAuditLogEntryDataFromClientAuditLog(&clientLogs[0])
+	systemEntry := MakeAuditLogEntryDataFromClientAuditLog(&clientLogs[1])
+
+	// Then
+	assert.Equal(t, int64(2), entry.ID.ValueInt64())
+	assert.Equal(t, "user@example.com", entry.Author.ValueString())
+	assert.Equal(t, int64(10), entry.ProjectID.ValueInt64())
+	assert.Equal(t, int64(20), entry.EnvironmentID.ValueInt64())
+	assert.Equal(t, "FEATURE_STATE", entry.RelatedObjectType.ValueString())
+	assert.Equal(t, "fs-uuid", entry.RelatedObjectUUID.ValueString())
+	assert.True(t, systemEntry.Author.IsNull())
+	assert.True(t, systemEntry.ProjectID.IsNull())
+	assert.True(t, systemEntry.EnvironmentID.IsNull())
+	assert.True(t, systemEntry.RelatedObjectUUID.IsNull())
+}
+
+func TestAuditLogQueryMatches(t *testing.T) {
+	// Given
+	data := AuditLogsDataSourceData{
+		ProjectID:     types.Int64Value(10),
+		EnvironmentID: types.Int64Null(),
+		Search:        types.StringNull(),
+		From:          types.StringValue("2024-01-02T00:00:00Z"),
+		To:            types.StringValue("2024-01-03T00:00:00Z"),
+	}
+
+	// When
+	query, err := data.ToClientAuditLogQuery()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), *query.ProjectID)
+	assert.Nil(t, query.EnvironmentID)
+
+	// and entries after the range are skipped, entries before it end the pagination
+	matches, more, err := query.matches(&AuditLog{CreatedDate: "2024-01-04T00:00:00Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, true}, []bool{matches, more})
+	matches, more, err = query.matches(&AuditLog{CreatedDate: "2024-01-02T12:00:00.5Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, true}, []bool{matches, more})
+	matches, more, err = query.matches(&AuditLog{CreatedDate: "2024-01-01T23:59:59Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, false}, []bool{matches, more})
+}
+
+func TestFeatureSegmentOverridesResourceDataToClientSegmentOverrideChanges(t *testing.T) {
+	// Given
+	liveOverride := func(featureSegmentID, segmentID, priority int64, enabled bool, value int64) *flagsmithapi.FeatureState {
+		return &flagsmithapi.FeatureState{
+			ID:                featureSegmentID * 10,
+			Enabled:           enabled,
+			FeatureStateValue: &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &value},
+			Feature:           1,
+			FeatureSegment:    &featureSegmentID,
+			Segment:           &segmentID,
+			SegmentPriority:   &priority,
+		}
+	}
+	liveOverrides := []*flagsmithapi.FeatureState{
+		liveOverride(100, 1, 0, true, 1),
+		liveOverride(200, 2, 1, true, 2),
+		liveOverride(300, 3, 2, true, 3),
+	}
+	intValue := func(value int64) *FeatureStateValue {
+		return &FeatureStateValue{
+			Type:         types.StringValue("int"),
+			StringValue:  types.StringNull(),
+			IntegerValue: types.Int64Value(value),
+			BooleanValue: types.BoolNull(),
+		}
+	}
+	data := FeatureSegmentOverridesResourceData{
+		EnvironmentKey: types.StringValue("env_key"),
+		Feature:        types.Int64Value(1),
+		SegmentOverrides: []SegmentOverrideResourceData{
+			// new override with the highest priority
+			{Segment: types.Int64Value(4), Enabled: types.BoolValue(true), FeatureStateValue: intValue(4)},
+			// unchanged override moved down
+			{Segment: types.Int64Value(1), Enabled: types.BoolValue(true), FeatureStateValue: intValue(1)},
+			// updated override
+			{Segment: types.Int64Value(3), Enabled: types.BoolValue(false), FeatureStateValue: intValue(30)},
+		},
+	}
+
+	// When
+	changes := data.ToClientSegmentOverrideChanges(liveOverrides)
+
+	// Then
+	assert.Equal(t, []int64{200}, changes.FeatureSegmentsToDelete)
+	assert.Equal(t, 1, len(changes.FeatureStatesToUpdate))
+	assert.Equal(t, int64(3000), changes.FeatureStatesToUpdate[0].ID)
+	assert.Equal(t, false, changes.FeatureStatesToUpdate[0].Enabled)
+	assert.Equal(t, int64(30), *changes.FeatureStatesToUpdate[0].FeatureStateValue.IntegerValue)
+	// and the live override is left untouched
+	assert.Equal(t, true, liveOverrides[2].Enabled)
+	assert.Equal(t, 1, len(changes.FeatureStatesToCreate))
+	assert.Equal(t, int64(4), *changes.FeatureStatesToCreate[0].Segment)
+	assert.Equal(t, int64(0), *changes.FeatureStatesToCreate[0].SegmentPriority)
+	assert.Equal(t, "env_key", changes.FeatureStatesToCreate[0].EnvironmentKey)
+
+	// and the priorities follow the order of the overrides once created
+	overrides := []*flagsmithapi.FeatureState{liveOverrides[0], liveOverrides[2], liveOverride(400, 4, 0, true, 4)}
+	assert.Equal(t, []FeatureSegmentPriority{{ID: 400, Priority: 0}, {ID: 100, Priority: 1}, {ID: 300, Priority: 2}}, data.ToClientFeatureSegmentPriorities(overrides))
+
+	// and the resource data is in order of priority
+	resourceData := MakeFeatureSegmentOverridesResourceDataFromClientFS("env_key", 1, liveOverrides)
+	assert.Equal(t, 3, len(resourceData.SegmentOverrides))
+	assert.Equal(t, int64(2), resourceData.SegmentOverrides[1].Segment.ValueInt64())
+	assert.Equal(t, *intValue(2), *resourceData.SegmentOverrides[1].FeatureStateValue)
+}
+
+func TestFeatureStateResourceDataToClientMultivariateFeatureStateValues(t *testing.T) {
+	// Given
+	options := []flagsmithapi.FeatureMultivariateOption{
+		{ID: 1, UUID: "option-a"},
+		{ID: 2, UUID: "option-b"},
+		{ID: 3, UUID: "option-c"},
+	}
+	current := []MultivariateFeatureStateValue{
+		{ID: 10, MultivariateFeatureOption: 1, PercentageAllocation: 20},
+		{ID: 20, MultivariateFeatureOption: 2, PercentageAllocation: 30},
+	}
+	data := FeatureStateResourceData{
+		Feature: types.Int64Value(1),
+		MultivariateValues: []MultivariateValue{
+			{MultivariateOptionUUID: types.StringValue("option-c"), PercentageAllocation: types.Float64Value(40)},
+			{MultivariateOptionUUID: types.StringValue("option-b"), PercentageAllocation: types.Float64Value(60)},
+		},
+	}
+
+	// When
+	values, err := data.ToClientMultivariateFeatureStateValues(options, current)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []MultivariateFeatureStateValue{
+		// option a is not listed hence it is set to 0
+		{ID: 10, MultivariateFeatureOption: 1, PercentageAllocation: 0},
+		{ID: 20, MultivariateFeatureOption: 2, PercentageAllocation: 60},
+		{MultivariateFeatureOption: 3, PercentageAllocation: 40},
+	}, values)
+
+	// and options of other features are rejected
+	data.MultivariateValues[0].MultivariateOptionUUID = types.StringValue("option-x")
+	_, err = data.ToClientMultivariateFeatureStateValues(options, current)
+	assert.Error(t, err)
+}
+
+func TestMakeMultivariateValuesFromClient(t *testing.T) {
+	// Given
+	options := []flagsmithapi.FeatureMultivariateOption{
+		{ID: 1, UUID: "option-a"},
+		{ID: 2, UUID: "option-b"},
+		{ID: 3, UUID: "option-c"},
+		{ID: 4, UUID: "option-d"},
+	}
+	clientValues := []MultivariateFeatureStateValue{
+		{ID: 10, MultivariateFeatureOption: 1, PercentageAllocation: 0},
+		{ID: 20, MultivariateFeatureOption: 2, PercentageAllocation: 60},
+		{ID: 30, MultivariateFeatureOption: 3, PercentageAllocation: 40},
+	}
+	prior := []MultivariateValue{
+		{MultivariateOptionUUID: types.StringValue("option-b"), PercentageAllocation: types.Float64Value(50)},
+		{MultivariateOptionUUID: types.StringValue("option-d"), PercentageAllocation: types.Float64Value(0)},
+	}
+
+	// When
+	values := MakeMultivariateValuesFromClient(clientValues, options, prior)
+
+	// Then the configured options come first, with no allocation if the API has no value, followed by the
+	// other options that have a percentage allocation
+	assert.Equal(t, []MultivariateValue{
+		{MultivariateOptionUUID: types.StringValue("option-b"), PercentageAllocation: types.Float64Value(60)},
+		{MultivariateOptionUUID: types.StringValue("option-d"), PercentageAllocation: types.Float64Value(0)},
+		{MultivariateOptionUUID: types.StringValue("option-c"), PercentageAllocation: types.Float64Value(40)},
+	}, values)
+}
+
+func TestFeatureExportResourceDataToClientTagIDs(t *testing.T) {
+	// Given
+	data := FeatureExportResourceData{}
+
+	// Then no tags are sent as an empty list
+	assert.Equal(t, []int64{}, data.ToClientTagIDs())
+
+	// When
+	data.TagIDs = []types.Int64{types.Int64Value(1), types.Int64Value(2)}
+
+	// Then
+	assert.Equal(t, []int64{1, 2}, data.ToClientTagIDs())
+}
+
+func TestMakeFeatureImportResourceDataFromClientFeatureImport(t *testing.T) {
+	// Given
+	clientImport := FeatureImport{ID: 1, EnvironmentID: 2, Strategy: "SKIP", Status: "PROCESSING", CreatedAt: "2024-01-02T15:04:05Z"}
+
+	// When
+	resourceData := MakeFeatureImportResourceDataFromClientFeatureImport(&clientImport)
+
+	// Then
+	assert.Equal(t, int64(1), resourceData.ID.ValueInt64())
+	assert.Equal(t, "SKIP", resourceData.Strategy.ValueString())
+	assert.Equal(t, "PROCESSING", resourceData.Status.ValueString())
+	assert.Equal(t, "2024-01-02T15:04:05Z", resourceData.CreatedAt.ValueString())
+	assert.True(t, resourceData.EnvironmentKey.IsNull())
+}
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/Flagsmith/flagsmith-go-api-client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +18,7 @@ const BaseAPIURL = "https://api.flagsmith.com/api/v1"
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ provider.Provider = &fsProvider{}
+var _ provider.ProviderWithEphemeralResources = &fsProvider{}
 
 type fsProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -67,10 +68,11 @@ func (p *fsProvider) Configure(ctx context.Context, req provider.ConfigureReques
 
 	}
 
-	client := flagsmithapi.NewClient(masterAPIKey, baseAPIURL)
+	client := NewClient(masterAPIKey, baseAPIURL)
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *fsProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -82,13 +84,47 @@ func (p *fsProvider) Resources(ctx context.Context) []func() resource.Resource {
 		newTagResource,
 		newProjectResource,
 		newEnvironmentResource,
+		newIdentityResource,
+		newIdentityFeatureStateResource,
+		newUserGroupResource,
+		newProjectPermissionResource,
+		newEnvironmentPermissionResource,
+		newRoleResource,
+		newRoleOrganisationPermissionResource,
+		newRoleProjectPermissionResource,
+		newRoleEnvironmentPermissionResource,
+		newRoleAssignmentResource,
+		newServerSideEnvironmentKeyResource,
+		newMasterAPIKeyResource,
+		newEnvironmentWebhookResource,
+		newOrganisationWebhookResource,
+		newEnvironmentIntegrationResource,
+		newProjectIntegrationResource,
+		newProjectSlackIntegrationResource,
+		newEnvironmentSlackChannelResource,
+		newEnvironmentFeatureVersionResource,
+		newMetadataFieldResource,
+		newMetadataModelFieldResource,
+		newOrganisationResource,
+		newOrganisationInviteResource,
+		newOrganisationUserResource,
+		newFeatureSegmentOverridesResource,
+		newFeatureExportResource,
+		newFeatureImportResource,
 	}
 
 }
 
+func (p *fsProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newServerSideEnvironmentKeyEphemeralResource,
+	}
+}
+
 func (p *fsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newOrganisationDataResource,
+		newAuditLogsDataResource,
 	}
 }
 
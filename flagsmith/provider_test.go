@@ -1,12 +1,11 @@
 package flagsmith_test
 
 import (
-	"github.com/Flagsmith/flagsmith-go-api-client"
 	"github.com/Flagsmith/terraform-provider-flagsmith/flagsmith"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
 	"strconv"
 	"testing"
@@ -29,6 +28,24 @@ func testAccPreCheck(t *testing.T) {
 	mustHaveEnv(t, "FLAGSMITH_ORGANISATION_UUID")
 }
 
+// testAccSlackPreCheck skips the test unless a Slack workspace is available,
+// the workspace must be authorised beforehand and can not be created by the tests
+func testAccSlackPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	if slackAPIToken() == "" || slackChannelName() == "" {
+		t.Skip("FLAGSMITH_SLACK_API_TOKEN and FLAGSMITH_SLACK_CHANNEL_NAME must be set for Slack acceptance tests")
+	}
+}
+
+// testAccOrganisationUserPreCheck skips the test unless a disposable organisation member is available,
+// the member is removed from the organisation when the test is destroyed
+func testAccOrganisationUserPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	if organisationUserEmail() == "" {
+		t.Skip("FLAGSMITH_ORGANISATION_USER_EMAIL must be set for organisation user acceptance tests")
+	}
+}
+
 func mustHaveEnv(t *testing.T, name string) {
 	if os.Getenv(name) == "" {
 		t.Fatalf("%s environment variable must be set for acceptance tests", name)
@@ -38,6 +55,15 @@ func mustHaveEnv(t *testing.T, name string) {
 func masterAPIKey() string {
 	return os.Getenv("FLAGSMITH_MASTER_API_KEY")
 }
+func slackAPIToken() string {
+	return os.Getenv("FLAGSMITH_SLACK_API_TOKEN")
+}
+func slackChannelName() string {
+	return os.Getenv("FLAGSMITH_SLACK_CHANNEL_NAME")
+}
+func organisationUserEmail() string {
+	return os.Getenv("FLAGSMITH_ORGANISATION_USER_EMAIL")
+}
 func projectUUID() string {
 	return os.Getenv("FLAGSMITH_PROJECT_UUID")
 }
@@ -79,16 +105,16 @@ func organisationUUID() string{
 	return os.Getenv("FLAGSMITH_ORGANISATION_UUID")
 }
 
-var tc *flagsmithapi.Client
+var tc *flagsmith.Client
 
-func testClient() *flagsmithapi.Client {
+func testClient() *flagsmith.Client {
 	baseAPIURL := os.Getenv("FLAGSMITH_BASE_API_URL")
 	if baseAPIURL == "" {
 		baseAPIURL = "https://api.flagsmith.com/api/v1"
 	}
 
 	if tc == nil {
-		tc = flagsmithapi.NewClient(masterAPIKey(), baseAPIURL)
+		tc = flagsmith.NewClient(masterAPIKey(), baseAPIURL)
 	}
 
 	return tc
@@ -3,7 +3,6 @@ package flagsmith
 import (
 	"context"
 	"fmt"
-	"github.com/Flagsmith/flagsmith-go-api-client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,19 +13,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &environmentResource{}
 var _ resource.ResourceWithImportState = &environmentResource{}
+var _ resource.ResourceWithModifyPlan = &environmentResource{}
 
 func newEnvironmentResource() resource.Resource {
 	return &environmentResource{}
 }
 
 type environmentResource struct {
-	client *flagsmithapi.Client
+	client *Client
 }
 
 func (r *environmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,11 +42,11 @@ func (r *environmentResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*flagsmithapi.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *flagsmithapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -127,10 +130,63 @@ func (t *environmentResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(true),
 				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
+			"use_v2_feature_versioning": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Manage the feature states of the environment through feature versions, see `flagsmith_environment_feature_version`. " +
+					"Once enabled, it can not be disabled. If unspecified, it is left as it is(i.e: false for new environments, unless cloned from an environment that uses it)",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"metadata": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Metadata of the environment, keyed by metadata field name. The fields must be attached to environments, see `flagsmith_metadata_model_field`",
+			},
+			"clone_from_environment_key": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Client side API key of an environment of the same project to clone, i.e: the environment starts as a copy " +
+					"of it, including segment overrides and identity overrides. The settings of this resource are applied to the copy. " +
+					"Changing it will force the environment to be re-created",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
 		},
 	}
 }
 
+func (r *environmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		var projectID types.Int64
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !projectID.IsUnknown() {
+			resp.Diagnostics.Append(validatePlannedMetadata(ctx, r.client, req.Plan, "", projectID.ValueInt64(), "environment")...)
+		}
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+	// Only an explicit false disables it, e.g: it may have been enabled outside of Terraform
+	var configured, current types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("use_v2_feature_versioning"), &configured)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("use_v2_feature_versioning"), &current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if current.ValueBool() && !configured.IsNull() && !configured.IsUnknown() && !configured.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("use_v2_feature_versioning"),
+			"Invalid Attribute Value",
+			"v2 feature versioning can not be disabled once enabled",
+		)
+	}
+}
+
 func (r *environmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data EnvironmentResourceData
 
@@ -144,18 +200,95 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 	clientEnvironment := data.ToClientEnvironment()
 
 	// Create the environment
-	err := r.client.CreateEnvironment(clientEnvironment)
+	var metadata []Metadata
+	var err error
+	if data.Metadata != nil {
+		metadata, err = makeProjectClientMetadata(r.client, data.Metadata, "", data.ProjectID.ValueInt64(), "environment")
+	}
+	makeResourceData := func() EnvironmentResourceData {
+		resourceData := MakeEnvironmentResourceDataFromClientEnvironment(clientEnvironment)
+		resourceData.UseV2FeatureVersioning = types.BoolValue(false)
+		resourceData.Metadata = data.Metadata
+		resourceData.CloneFromEnvironmentKey = data.CloneFromEnvironmentKey
+		return resourceData
+	}
+	if err == nil {
+		switch {
+		case !data.CloneFromEnvironmentKey.IsNull():
+			err = r.cloneEnvironment(data.CloneFromEnvironmentKey.ValueString(), clientEnvironment)
+			if err != nil {
+				break
+			}
+			// Save the copy first, so that it's tainted(instead of being left behind) if applying the settings fails
+			resourceData := makeResourceData()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &resourceData)...)
+			if metadata == nil {
+				err = r.client.UpdateEnvironment(clientEnvironment)
+			} else {
+				err = r.client.UpdateEnvironmentWithMetadata(clientEnvironment, metadata)
+			}
+		case data.Metadata == nil:
+			err = r.client.CreateEnvironment(clientEnvironment)
+		default:
+			err = r.client.CreateEnvironmentWithMetadata(clientEnvironment, metadata)
+		}
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment, got error: %s", err))
 		return
 	}
-	resourceData := MakeEnvironmentResourceDataFromClientEnvironment(clientEnvironment)
+	resourceData := makeResourceData()
+
+	if !data.CloneFromEnvironmentKey.IsNull() {
+		// The copy inherits the feature versioning of the source environment
+		useV2FeatureVersioning, err := r.client.UsesV2FeatureVersioning(clientEnvironment.APIKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+			return
+		}
+		resourceData.UseV2FeatureVersioning = types.BoolValue(useV2FeatureVersioning)
+		if useV2FeatureVersioning && !data.UseV2FeatureVersioning.IsNull() && !data.UseV2FeatureVersioning.ValueBool() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &resourceData)...)
+			resp.Diagnostics.AddAttributeError(
+				path.Root("use_v2_feature_versioning"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Environment %q uses v2 feature versioning, hence its copy does too, set `use_v2_feature_versioning` to true or leave it unset", data.CloneFromEnvironmentKey.ValueString()),
+			)
+			return
+		}
+	}
+
+	if data.UseV2FeatureVersioning.ValueBool() && !resourceData.UseV2FeatureVersioning.ValueBool() {
+		// Save the environment first, the migration may fail(or time out)
+		diags = resp.State.Set(ctx, &resourceData)
+		resp.Diagnostics.Append(diags...)
+
+		err = r.client.EnableV2FeatureVersioning(ctx, clientEnvironment.APIKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable v2 feature versioning, got error: %s", err))
+			return
+		}
+		resourceData.UseV2FeatureVersioning = types.BoolValue(true)
+	}
 
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
 }
 
+// cloneEnvironment creates environment as a copy of the source environment, the settings of environment are
+// not applied to the copy
+func (r *environmentResource) cloneEnvironment(sourceEnvironmentKey string, environment *flagsmithapi.Environment) error {
+	source, err := r.client.GetEnvironment(sourceEnvironmentKey)
+	if err != nil {
+		return err
+	}
+	if source.ProjectID != environment.ProjectID {
+		return fmt.Errorf("environment %q belongs to project %d, environments can only be cloned within the same project", sourceEnvironmentKey, source.ProjectID)
+	}
+	return r.client.CloneEnvironment(sourceEnvironmentKey, environment)
+}
+
 func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data EnvironmentResourceData
 	diags := req.State.Get(ctx, &data)
@@ -173,6 +306,26 @@ func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	resourceData := MakeEnvironmentResourceDataFromClientEnvironment(environment)
 
+	useV2FeatureVersioning, err := r.client.UsesV2FeatureVersioning(environment.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+	resourceData.UseV2FeatureVersioning = types.BoolValue(useV2FeatureVersioning)
+	resourceData.CloneFromEnvironmentKey = data.CloneFromEnvironmentKey
+
+	// Metadata is only managed if set
+	if data.Metadata != nil {
+		metadata, err := r.client.GetEnvironmentMetadata(environment.APIKey)
+		if err == nil {
+			resourceData.Metadata, err = makeProjectMetadataMap(r.client, metadata, environment.ProjectID, "environment")
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment metadata, got error: %s", err))
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
 
@@ -192,13 +345,43 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 	// Generate API request body from plan
 	clientEnvironment := plan.ToClientEnvironment()
 
-	err := r.client.UpdateEnvironment(clientEnvironment)
+	var currentMetadata types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("metadata"), &currentMetadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var err error
+	if plan.Metadata == nil && currentMetadata.IsNull() {
+		err = r.client.UpdateEnvironment(clientEnvironment)
+	} else {
+		var metadata []Metadata
+		metadata, err = makeProjectClientMetadata(r.client, plan.Metadata, "", plan.ProjectID.ValueInt64(), "environment")
+		if err == nil {
+			err = r.client.UpdateEnvironmentWithMetadata(clientEnvironment, metadata)
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment, got error: %s", err))
 		return
 	}
 
 	resourceData := MakeEnvironmentResourceDataFromClientEnvironment(clientEnvironment)
+	resourceData.UseV2FeatureVersioning = plan.UseV2FeatureVersioning
+	resourceData.Metadata = plan.Metadata
+	resourceData.CloneFromEnvironmentKey = plan.CloneFromEnvironmentKey
+
+	var useV2FeatureVersioning types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("use_v2_feature_versioning"), &useV2FeatureVersioning)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.UseV2FeatureVersioning.ValueBool() && !useV2FeatureVersioning.ValueBool() {
+		err = r.client.EnableV2FeatureVersioning(ctx, clientEnvironment.APIKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable v2 feature versioning, got error: %s", err))
+			return
+		}
+	}
 
 	// Update the state with the new values
 	diags = resp.State.Set(ctx, &resourceData)
@@ -0,0 +1,327 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &environmentFeatureVersionResource{}
+var _ resource.ResourceWithImportState = &environmentFeatureVersionResource{}
+var _ resource.ResourceWithValidateConfig = &environmentFeatureVersionResource{}
+
+func newEnvironmentFeatureVersionResource() resource.Resource {
+	return &environmentFeatureVersionResource{}
+}
+
+type environmentFeatureVersionResource struct {
+	client *Client
+}
+
+func (r *environmentFeatureVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_feature_version"
+}
+
+func (r *environmentFeatureVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func requiredFeatureStateValueAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Required:            true,
+		MarkdownDescription: "Value for the feature State. NOTE: One of string_value, integer_value or boolean_value must be set",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the feature state value, can be `unicode`, `int` or `bool`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"unicode", "int", "bool"}...),
+				},
+			},
+			"string_value": schema.StringAttribute{
+				MarkdownDescription: "String value of the feature if the type is `unicode`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\S[\s\S]*\S$|^$`),
+						"Leading and trailing whitespace is not allowed",
+					),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("integer_value"),
+						path.MatchRelative().AtParent().AtName("boolean_value"),
+					),
+				},
+			},
+			"integer_value": schema.Int64Attribute{
+				MarkdownDescription: "Integer value of the feature if the type is `int`",
+				Optional:            true,
+			},
+			"boolean_value": schema.BoolAttribute{
+				MarkdownDescription: "Boolean value of the feature if the type is `bool`",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *environmentFeatureVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Environment feature version: manages the feature states of a feature in an environment that uses " +
+			"v2 feature versioning. Every change publishes the environment default and the segment overrides as a single new version. " +
+			"NOTE: versions can not be deleted, destroying the resource leaves the live version untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the live version",
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side environment key associated with the environment",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"environment_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the environment",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"feature_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the feature",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Used for enabling/disabling the feature in the environment",
+			},
+			"feature_state_value": requiredFeatureStateValueAttribute(),
+			"segment_overrides": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Segment overrides of the feature, in order of priority(i.e: the first one has the highest priority). " +
+					"Overrides that are not listed are removed",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"segment_id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "ID of the segment",
+						},
+						"enabled": schema.BoolAttribute{
+							Required:            true,
+							MarkdownDescription: "Used for enabling/disabling the feature for the segment",
+						},
+						"feature_state_value": requiredFeatureStateValueAttribute(),
+					},
+				},
+			},
+			"version_history": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUIDs of the versions that have been live, oldest first",
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *environmentFeatureVersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateUniqueSegmentOverrides(ctx, req.Config)...)
+}
+
+// environmentID returns the ID of the environment, which is required by the versioning endpoints
+func (r *environmentFeatureVersionResource) environmentID(environmentKey string) (int64, error) {
+	environment, err := r.client.getEnvironmentSettings(environmentKey)
+	if err != nil {
+		return 0, err
+	}
+	if !environment.UseV2FeatureVersioning {
+		return 0, fmt.Errorf("environment %q does not use v2 feature versioning", environmentKey)
+	}
+	return environment.ID, nil
+}
+
+// read returns the resource data of the live version. publishedUUID is the version that has just been
+// published, if any, in case its `live_from` is a bit ahead of the local clock
+func (r *environmentFeatureVersionResource) read(environmentKey string, environmentID, featureID int64, publishedUUID string) (*EnvironmentFeatureVersionResourceData, error) {
+	versions, err := r.client.GetFeatureVersions(environmentID, featureID)
+	if err != nil {
+		return nil, err
+	}
+	if publishedUUID != "" && !slices.ContainsFunc(versions, func(version FeatureVersion) bool { return version.UUID == publishedUUID }) {
+		versions = append(versions, FeatureVersion{UUID: publishedUUID})
+	}
+	if len(versions) == 0 {
+		return nil, NotFoundError{kind: "live feature version", id: strconv.FormatInt(featureID, 10)}
+	}
+	featureStates, err := r.client.GetFeatureVersionFeatureStates(environmentID, featureID, versions[len(versions)-1].UUID)
+	if err != nil {
+		return nil, err
+	}
+	resourceData := MakeEnvironmentFeatureVersionResourceDataFromClientVersions(versions, featureStates, environmentKey, environmentID, featureID)
+	return &resourceData, nil
+}
+
+// publish creates a new version with the planned feature states and returns the resulting resource data
+func (r *environmentFeatureVersionResource) publish(plan EnvironmentFeatureVersionResourceData) (*EnvironmentFeatureVersionResourceData, error) {
+	environmentKey := plan.EnvironmentKey.ValueString()
+	featureID := plan.Feature.ValueInt64()
+	environmentID, err := r.environmentID(environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := r.client.GetFeatureVersions(environmentID, featureID)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, NotFoundError{kind: "live feature version", id: strconv.FormatInt(featureID, 10)}
+	}
+	liveFeatureStates, err := r.client.GetFeatureVersionFeatureStates(environmentID, featureID, versions[len(versions)-1].UUID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := r.client.CreateFeatureVersion(environmentID, featureID, plan.ToClientFeatureVersionChanges(liveFeatureStates))
+	if err != nil {
+		return nil, err
+	}
+	resourceData, err := r.read(environmentKey, environmentID, featureID, version.UUID)
+	if err != nil {
+		return nil, err
+	}
+	// Keep an empty list of overrides as configured
+	if resourceData.SegmentOverrides == nil && plan.SegmentOverrides != nil {
+		resourceData.SegmentOverrides = []SegmentOverrideResourceData{}
+	}
+	return resourceData, nil
+}
+
+func (r *environmentFeatureVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentFeatureVersionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceData, err := r.publish(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment feature version, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentFeatureVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentFeatureVersionResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	// environment_id is not set after import
+	environmentID := data.Environment.ValueInt64()
+	if data.Environment.IsNull() {
+		var err error
+		environmentID, err = r.environmentID(data.EnvironmentKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+			return
+		}
+	}
+
+	resourceData, err := r.read(data.EnvironmentKey.ValueString(), environmentID, data.Feature.ValueInt64(), "")
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment feature version, got error: %s", err))
+		return
+	}
+	if resourceData.SegmentOverrides == nil && data.SegmentOverrides != nil {
+		resourceData.SegmentOverrides = []SegmentOverrideResourceData{}
+	}
+
+	diags = resp.State.Set(ctx, resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentFeatureVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan EnvironmentFeatureVersionResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	resourceData, err := r.publish(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment feature version, got error: %s", err))
+		return
+	}
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentFeatureVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Versions can not be deleted, the live version is left as is
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *environmentFeatureVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_key,feature_id Got: %q", req.ID),
+		)
+		return
+	}
+	featureID, err := strconv.ParseInt(importKey[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("feature_id must be an integer, got: %q", importKey[1]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature_id"), featureID)...)
+}
@@ -0,0 +1,142 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccEnvironmentFeatureVersionResource(t *testing.T) {
+	name := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test duplicate segment overrides validation
+			{
+				Config:      testAccEnvironmentFeatureVersionResourceConfig(name, "value_one", `[1, 1]`),
+				ExpectError: regexp.MustCompile(`Segment \d+ is overridden more than once`),
+				PlanOnly:    true,
+			},
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentFeatureVersionResourceConfig(name, "value_one", `[flagsmith_segment.first.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment.test_environment", "use_v2_feature_versioning", "true"),
+					resource.TestCheckResourceAttr("flagsmith_environment_feature_version.test_version", "enabled", "true"),
+					resource.TestCheckResourceAttr("flagsmith_environment_feature_version.test_version", "feature_state_value.string_value", "value_one"),
+					resource.TestCheckResourceAttr("flagsmith_environment_feature_version.test_version", "segment_overrides.#", "1"),
+					resource.TestCheckResourceAttrPair("flagsmith_environment_feature_version.test_version", "segment_overrides.0.segment_id", "flagsmith_segment.first", "id"),
+					resource.TestCheckResourceAttrPair("flagsmith_environment_feature_version.test_version", "environment_id", "flagsmith_environment.test_environment", "id"),
+					resource.TestCheckResourceAttrSet("flagsmith_environment_feature_version.test_version", "uuid"),
+					resource.TestCheckResourceAttrSet("flagsmith_environment_feature_version.test_version", "version_history.0"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_environment_feature_version.test_version",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					environmentKey, err := getAttributefromState(s, "flagsmith_environment_feature_version.test_version", "environment_key")
+					if err != nil {
+						return "", err
+					}
+					featureID, err := getAttributefromState(s, "flagsmith_environment_feature_version.test_version", "feature_id")
+					if err != nil {
+						return "", err
+					}
+					return fmt.Sprintf("%s,%s", environmentKey, featureID), nil
+				},
+			},
+
+			// Update testing: add an override with the highest priority
+			{
+				Config: testAccEnvironmentFeatureVersionResourceConfig(name, "value_two", `[flagsmith_segment.second.id, flagsmith_segment.first.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_feature_version.test_version", "feature_state_value.string_value", "value_two"),
+					resource.TestCheckResourceAttr("flagsmith_environment_feature_version.test_version", "segment_overrides.#", "2"),
+					resource.TestCheckResourceAttrPair("flagsmith_environment_feature_version.test_version", "segment_overrides.0.segment_id", "flagsmith_segment.second", "id"),
+					resource.TestCheckResourceAttrPair("flagsmith_environment_feature_version.test_version", "segment_overrides.1.segment_id", "flagsmith_segment.first", "id"),
+					resource.TestCheckResourceAttrSet("flagsmith_environment_feature_version.test_version", "version_history.1"),
+				),
+			},
+
+			// Update testing: remove the overrides
+			{
+				Config: testAccEnvironmentFeatureVersionResourceConfig(name, "value_two", `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_feature_version.test_version", "segment_overrides.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentFeatureVersionResourceConfig(name, value, segmentIDs string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_environment" "test_environment" {
+  name                      = "%[1]s"
+  project_id                = %[2]d
+  use_v2_feature_versioning = true
+}
+
+resource "flagsmith_feature" "test_feature" {
+  feature_name = "%[1]s"
+  project_uuid = "%[3]s"
+  type         = "STANDARD"
+}
+
+resource "flagsmith_segment" "first" {
+  name         = "%[1]s_first"
+  project_uuid = "%[3]s"
+  rules = [{
+    type = "ALL"
+    rules = [{
+      type       = "ANY"
+      conditions = [{ operator = "EQUAL", property = "device_type", value = "mobile" }]
+    }]
+  }]
+}
+
+resource "flagsmith_segment" "second" {
+  name         = "%[1]s_second"
+  project_uuid = "%[3]s"
+  rules = [{
+    type = "ALL"
+    rules = [{
+      type       = "ANY"
+      conditions = [{ operator = "EQUAL", property = "device_type", value = "desktop" }]
+    }]
+  }]
+}
+
+resource "flagsmith_environment_feature_version" "test_version" {
+  environment_key = flagsmith_environment.test_environment.api_key
+  feature_id      = flagsmith_feature.test_feature.id
+  enabled         = true
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "%[4]s"
+  }
+  segment_overrides = [for segment_id in %[5]s : {
+    segment_id = segment_id
+    enabled    = true
+    feature_state_value = {
+      type         = "unicode"
+      string_value = "override"
+    }
+  }]
+}
+
+`, name, projectID(), projectUUID(), value, segmentIDs)
+}
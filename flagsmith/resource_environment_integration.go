@@ -0,0 +1,270 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &environmentIntegrationResource{}
+var _ resource.ResourceWithImportState = &environmentIntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &environmentIntegrationResource{}
+
+func newEnvironmentIntegrationResource() resource.Resource {
+	return &environmentIntegrationResource{}
+}
+
+type environmentIntegrationResource struct {
+	client *Client
+}
+
+func (r *environmentIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_integration"
+}
+
+func (r *environmentIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (t *environmentIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Environment Integration: sends the flag evaluations of an environment to an analytics platform",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the integration",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side key of the environment",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of the integration, can be `amplitude`, `segment`, `mixpanel`, `heap` or `rudderstack`",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(environmentIntegrationTypes...),
+				},
+			},
+			"amplitude": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the Amplitude integration, required if `type` is `amplitude`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Amplitude API key",
+					},
+					"base_url": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Base URL of the Amplitude API, e.g: for self-hosted or EU data residency instances",
+					},
+				},
+			},
+			"segment": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the Segment integration, required if `type` is `segment`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Segment API key",
+					},
+					"base_url": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Base URL of the Segment API, e.g: for self-hosted or EU data residency instances",
+					},
+				},
+			},
+			"mixpanel": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the Mixpanel integration, required if `type` is `mixpanel`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Mixpanel API key",
+					},
+				},
+			},
+			"heap": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the Heap integration, required if `type` is `heap`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Heap API key",
+					},
+				},
+			},
+			"rudderstack": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the RudderStack integration, required if `type` is `rudderstack`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "RudderStack API key",
+					},
+					"base_url": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Base URL of the RudderStack API, e.g: for self-hosted or EU data residency instances",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *environmentIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateIntegrationBlocks(ctx, req.Config, environmentIntegrationTypes)...)
+}
+
+func (r *environmentIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentIntegrationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientIntegration := data.ToClientEnvironmentIntegration()
+	err := r.client.CreateEnvironmentIntegration(data.EnvironmentKey.ValueString(), data.Type.ValueString(), clientIntegration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment integration, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeEnvironmentIntegrationResourceDataFromClientEnvironmentIntegration(clientIntegration, data.EnvironmentKey.ValueString(), data.Type.ValueString())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentIntegrationResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	integration, err := r.client.GetEnvironmentIntegration(data.EnvironmentKey.ValueString(), data.Type.ValueString(), data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment integration, got error: %s", err))
+		return
+	}
+	resourceData := MakeEnvironmentIntegrationResourceDataFromClientEnvironmentIntegration(integration, data.EnvironmentKey.ValueString(), data.Type.ValueString())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan EnvironmentIntegrationResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientIntegration := plan.ToClientEnvironmentIntegration()
+	err := r.client.UpdateEnvironmentIntegration(plan.EnvironmentKey.ValueString(), plan.Type.ValueString(), clientIntegration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment integration, got error: %s", err))
+		return
+	}
+	resourceData := MakeEnvironmentIntegrationResourceDataFromClientEnvironmentIntegration(clientIntegration, plan.EnvironmentKey.ValueString(), plan.Type.ValueString())
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state EnvironmentIntegrationResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteEnvironmentIntegration(state.EnvironmentKey.ValueString(), state.Type.ValueString(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete environment integration, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *environmentIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 3 || importKey[0] == "" || importKey[1] == "" || importKey[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_key,type,integration_id Got: %q", req.ID),
+		)
+		return
+	}
+	if !slices.Contains(environmentIntegrationTypes, importKey[1]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("type must be one of %s, got: %q", strings.Join(environmentIntegrationTypes, ", "), importKey[1]),
+		)
+		return
+	}
+	integrationID, err := strconv.ParseInt(importKey[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("integration_id must be an integer, got: %q", importKey[2]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), importKey[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), integrationID)...)
+}
@@ -0,0 +1,94 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccEnvironmentIntegrationResource(t *testing.T) {
+	apiKey := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentIntegrationResourceDestroy,
+		Steps: []resource.TestStep{
+			// Block not matching the type
+			{
+				Config:      testAccEnvironmentIntegrationResourceConfig("amplitude", apiKey, "https://api.eu.segment.io"),
+				ExpectError: regexp.MustCompile("`amplitude` must be set when type is \"amplitude\""),
+			},
+
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentIntegrationResourceConfig("segment", apiKey, "https://api.eu.segment.io"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_integration.test_integration", "environment_key", environmentKey()),
+					resource.TestCheckResourceAttr("flagsmith_environment_integration.test_integration", "type", "segment"),
+					resource.TestCheckResourceAttr("flagsmith_environment_integration.test_integration", "segment.api_key", apiKey),
+					resource.TestCheckResourceAttr("flagsmith_environment_integration.test_integration", "segment.base_url", "https://api.eu.segment.io"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_environment_integration.test_integration",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_environment_integration.test_integration", "id")
+					return fmt.Sprintf("%s,segment,%s", environmentKey(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccEnvironmentIntegrationResourceConfig("segment", apiKey+"_updated", "https://api.segment.io"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_integration.test_integration", "segment.api_key", apiKey+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_environment_integration.test_integration", "segment.base_url", "https://api.segment.io"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentIntegrationResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_environment_integration.test_integration", "id")
+	if err != nil {
+		return err
+	}
+	integrationID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetEnvironmentIntegration(environmentKey(), "segment", integrationID)
+	if err == nil {
+		return fmt.Errorf("environment integration still exists")
+	}
+	return nil
+}
+
+func testAccEnvironmentIntegrationResourceConfig(integrationType, apiKey, baseURL string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_environment_integration" "test_integration" {
+  environment_key = "%s"
+  type            = "%s"
+  segment = {
+    api_key  = "%s"
+    base_url = "%s"
+  }
+}
+
+`, environmentKey(), integrationType, apiKey, baseURL)
+}
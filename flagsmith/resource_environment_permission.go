@@ -0,0 +1,285 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &environmentPermissionResource{}
+var _ resource.ResourceWithImportState = &environmentPermissionResource{}
+var _ resource.ResourceWithModifyPlan = &environmentPermissionResource{}
+
+func newEnvironmentPermissionResource() resource.Resource {
+	return &environmentPermissionResource{}
+}
+
+type environmentPermissionResource struct {
+	client *Client
+}
+
+func (r *environmentPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_permission"
+}
+
+func (r *environmentPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (t *environmentPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Environment Permission: permissions of a user or a group on an environment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the permission object",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Client side key of the environment. Exactly one of `environment_key` or `environment_uuid` must be set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("environment_uuid")),
+				},
+			},
+			"environment_uuid": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "UUID of the environment. Exactly one of `environment_key` or `environment_uuid` must be set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user. Exactly one of `user_id` or `group_id` must be set",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("group_id")),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user group. Exactly one of `user_id` or `group_id` must be set",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"admin": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Grants admin access to the environment. If unspecified, it will default to false",
+				Default:             booldefault.StaticBool(false),
+			},
+			"permissions": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of permission keys(e.g: `VIEW_ENVIRONMENT`, `UPDATE_FEATURE_STATE`, `MANAGE_IDENTITIES`) granted on the environment",
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *environmentPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if nothing changes
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+	var permissionSet types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissionSet)...)
+	// The permissions can not be validated until they are known, e.g: they depend on other resources
+	if resp.Diagnostics.HasError() || permissionSet.IsUnknown() {
+		return
+	}
+	var permissions []types.String
+	resp.Diagnostics.Append(permissionSet.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validatePermissionKeys(r.client, "environments", path.Root("permissions"), permissions)...)
+}
+
+// resolveEnvironment returns the key and the uuid of the environment
+// using whichever of the two is known
+func (r *environmentPermissionResource) resolveEnvironment(data *EnvironmentPermissionResourceData) (string, string, error) {
+	if !data.EnvironmentKey.IsNull() && !data.EnvironmentKey.IsUnknown() && !data.EnvironmentUUID.IsNull() && !data.EnvironmentUUID.IsUnknown() {
+		return data.EnvironmentKey.ValueString(), data.EnvironmentUUID.ValueString(), nil
+	}
+	if !data.EnvironmentKey.IsNull() && !data.EnvironmentKey.IsUnknown() {
+		environment, err := r.client.GetEnvironment(data.EnvironmentKey.ValueString())
+		if err != nil {
+			return "", "", err
+		}
+		return environment.APIKey, environment.UUID, nil
+	}
+	environment, err := r.client.GetEnvironmentByUUID(data.EnvironmentUUID.ValueString())
+	if err != nil {
+		return "", "", err
+	}
+	return environment.APIKey, environment.UUID, nil
+}
+
+func (r *environmentPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentPermissionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentKey, environmentUUID, err := r.resolveEnvironment(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
+	clientPermission := data.ToClientPermission()
+	err = r.client.CreateEnvironmentPermission(environmentKey, clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment permission, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeEnvironmentPermissionResourceDataFromClientPermission(clientPermission, environmentKey, environmentUUID)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentPermissionResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	// Only one of environment_key/environment_uuid is known after import
+	environmentKey, environmentUUID, err := r.resolveEnvironment(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
+	clientPermission := data.ToClientPermission()
+	// The permission is looked up by user/group after import
+	if data.ID.IsNull() {
+		clientPermission, err = r.client.FindEnvironmentPermission(environmentKey, clientPermission.User, clientPermission.Group)
+	} else {
+		clientPermission, err = r.client.GetEnvironmentPermission(environmentKey, data.ID.ValueInt64(), !data.GroupID.IsNull())
+	}
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment permission, got error: %s", err))
+		return
+	}
+	resourceData := MakeEnvironmentPermissionResourceDataFromClientPermission(clientPermission, environmentKey, environmentUUID)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan EnvironmentPermissionResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientPermission := plan.ToClientPermission()
+	err := r.client.UpdateEnvironmentPermission(plan.EnvironmentKey.ValueString(), clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment permission, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeEnvironmentPermissionResourceDataFromClientPermission(clientPermission, plan.EnvironmentKey.ValueString(), plan.EnvironmentUUID.ValueString())
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state EnvironmentPermissionResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteEnvironmentPermission(state.EnvironmentKey.ValueString(), state.ID.ValueInt64(), !state.GroupID.IsNull())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete environment permission, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func (r *environmentPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	environment, subjectAttribute, subjectID, diags := parsePermissionImportID(req.ID, "environment_key(or environment_uuid)")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if uuidRegex.MatchString(environment) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_uuid"), environment)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), environment)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(subjectAttribute), subjectID)...)
+}
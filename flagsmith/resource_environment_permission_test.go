@@ -0,0 +1,158 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestAccEnvironmentPermissionResourceUser(t *testing.T) {
+	userID := 3936
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentPermissionResourceDestroy(false),
+		Steps: []resource.TestStep{
+			// Invalid permission key
+			{
+				Config:      testAccEnvironmentPermissionUserResourceConfig(userID, false, `["NOT_A_PERMISSION"]`),
+				ExpectError: regexp.MustCompile(`"NOT_A_PERMISSION" is not a valid permission`),
+			},
+
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentPermissionUserResourceConfig(userID, false, `["VIEW_ENVIRONMENT", "UPDATE_FEATURE_STATE"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_permission.test_permission", "environment_key", environmentKey()),
+					resource.TestCheckResourceAttrSet("flagsmith_environment_permission.test_permission", "environment_uuid"),
+					resource.TestCheckResourceAttr("flagsmith_environment_permission.test_permission", "user_id", fmt.Sprintf("%d", userID)),
+					resource.TestCheckResourceAttr("flagsmith_environment_permission.test_permission", "admin", "false"),
+					resource.TestCheckResourceAttr("flagsmith_environment_permission.test_permission", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_environment_permission.test_permission", "permissions.*", "VIEW_ENVIRONMENT"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_environment_permission.test_permission", "permissions.*", "UPDATE_FEATURE_STATE"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_environment_permission.test_permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s,user,%d", environmentKey(), userID),
+			},
+
+			// Update testing
+			{
+				Config: testAccEnvironmentPermissionUserResourceConfig(userID, true, `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_permission.test_permission", "admin", "true"),
+					resource.TestCheckResourceAttr("flagsmith_environment_permission.test_permission", "permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEnvironmentPermissionResourceGroup(t *testing.T) {
+	name := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentPermissionResourceDestroy(true),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentPermissionGroupResourceConfig(name, `["UPDATE_FEATURE_STATE"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("flagsmith_environment_permission.test_permission", "environment_key", "flagsmith_environment.test_environment", "api_key"),
+					resource.TestCheckResourceAttrPair("flagsmith_environment_permission.test_permission", "group_id", "flagsmith_user_group.test_group", "id"),
+					resource.TestCheckResourceAttr("flagsmith_environment_permission.test_permission", "permissions.#", "1"),
+				),
+			},
+
+			// ImportState testing(by environment uuid)
+			{
+				ResourceName:      "flagsmith_environment_permission.test_permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					environmentUUID, err := getAttributefromState(s, "flagsmith_environment.test_environment", "uuid")
+					if err != nil {
+						return "", err
+					}
+					groupID, err := getAttributefromState(s, "flagsmith_user_group.test_group", "id")
+					return fmt.Sprintf("%s,group,%s", environmentUUID, groupID), err
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentPermissionResourceDestroy(group bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		environmentKey, err := getAttributefromState(s, "flagsmith_environment_permission.test_permission", "environment_key")
+		if err != nil {
+			return err
+		}
+		id, err := getAttributefromState(s, "flagsmith_environment_permission.test_permission", "id")
+		if err != nil {
+			return err
+		}
+		permissionID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return err
+		}
+		_, err = testClient().GetEnvironmentPermission(environmentKey, permissionID, group)
+		if err == nil {
+			return fmt.Errorf("environment permission still exists")
+		}
+		return nil
+	}
+}
+
+func testAccEnvironmentPermissionUserResourceConfig(userID int, admin bool, permissions string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_environment_permission" "test_permission" {
+  environment_key = "%s"
+  user_id         = %d
+  admin           = %t
+  permissions     = %s
+}
+
+`, environmentKey(), userID, admin, permissions)
+}
+
+func testAccEnvironmentPermissionGroupResourceConfig(name, permissions string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_environment" "test_environment" {
+  name        = "%s"
+  project_id  = %d
+  description = "environment permission test"
+}
+
+resource "flagsmith_user_group" "test_group" {
+  organisation_id = %d
+  name            = "%s"
+}
+
+resource "flagsmith_environment_permission" "test_permission" {
+  environment_uuid = flagsmith_environment.test_environment.uuid
+  group_id         = flagsmith_user_group.test_group.id
+  permissions      = %s
+}
+
+`, name, projectID(), organisationID(), name, permissions)
+}
@@ -0,0 +1,224 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &environmentSlackChannelResource{}
+var _ resource.ResourceWithImportState = &environmentSlackChannelResource{}
+
+func newEnvironmentSlackChannelResource() resource.Resource {
+	return &environmentSlackChannelResource{}
+}
+
+type environmentSlackChannelResource struct {
+	client *Client
+}
+
+func (r *environmentSlackChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_slack_channel"
+}
+
+func (r *environmentSlackChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (t *environmentSlackChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Environment Slack Channel: Slack channel the flag changes of an environment are posted to. " +
+			"Requires the Slack integration of the project, see `flagsmith_project_slack_integration`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the slack channel configuration",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side key of the environment",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"channel_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the Slack channel, without the leading `#`",
+			},
+			"channel_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the Slack channel",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the changes are posted to the channel. If unspecified, it will default to true",
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *environmentSlackChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentSlackChannelResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetSlackChannelByName(data.EnvironmentKey.ValueString(), data.ChannelName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read slack channel, got error: %s", err))
+		return
+	}
+
+	clientConfiguration := data.ToClientSlackEnvironmentConfiguration(channel.ChannelID)
+	err = r.client.CreateEnvironmentSlackConfiguration(data.EnvironmentKey.ValueString(), clientConfiguration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment slack channel, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeEnvironmentSlackChannelResourceDataFromClientConfiguration(clientConfiguration, data.EnvironmentKey.ValueString(), channel.ChannelName)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentSlackChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentSlackChannelResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	configuration, err := r.client.GetEnvironmentSlackConfiguration(data.EnvironmentKey.ValueString(), data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment slack channel, got error: %s", err))
+		return
+	}
+
+	// The API only stores the channel ID, look up the name if the channel has
+	// changed(or after import)
+	channelName := data.ChannelName.ValueString()
+	if data.ChannelName.IsNull() || data.ChannelID.ValueString() != configuration.ChannelID {
+		channel, err := r.client.GetSlackChannelByID(data.EnvironmentKey.ValueString(), configuration.ChannelID)
+		switch err.(type) {
+		case nil:
+			channelName = channel.ChannelName
+		case NotFoundError:
+			// e.g: the channel has been archived, fall back to the ID to surface the drift
+			channelName = configuration.ChannelID
+		default:
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read slack channel, got error: %s", err))
+			return
+		}
+	}
+	resourceData := MakeEnvironmentSlackChannelResourceDataFromClientConfiguration(configuration, data.EnvironmentKey.ValueString(), channelName)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentSlackChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan EnvironmentSlackChannelResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	channel, err := r.client.GetSlackChannelByName(plan.EnvironmentKey.ValueString(), plan.ChannelName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read slack channel, got error: %s", err))
+		return
+	}
+
+	clientConfiguration := plan.ToClientSlackEnvironmentConfiguration(channel.ChannelID)
+	err = r.client.UpdateEnvironmentSlackConfiguration(plan.EnvironmentKey.ValueString(), clientConfiguration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment slack channel, got error: %s", err))
+		return
+	}
+	resourceData := MakeEnvironmentSlackChannelResourceDataFromClientConfiguration(clientConfiguration, plan.EnvironmentKey.ValueString(), channel.ChannelName)
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentSlackChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state EnvironmentSlackChannelResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteEnvironmentSlackConfiguration(state.EnvironmentKey.ValueString(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete environment slack channel, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *environmentSlackChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_key,configuration_id Got: %q", req.ID),
+		)
+		return
+	}
+	configurationID, err := strconv.ParseInt(importKey[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("configuration_id must be an integer, got: %q", importKey[1]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), configurationID)...)
+}
@@ -0,0 +1,88 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccEnvironmentSlackChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccSlackPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentSlackChannelResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentSlackChannelResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_slack_channel.test_channel", "environment_key", environmentKey()),
+					resource.TestCheckResourceAttr("flagsmith_environment_slack_channel.test_channel", "channel_name", slackChannelName()),
+					resource.TestCheckResourceAttr("flagsmith_environment_slack_channel.test_channel", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("flagsmith_environment_slack_channel.test_channel", "channel_id"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_environment_slack_channel.test_channel",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_environment_slack_channel.test_channel", "id")
+					return fmt.Sprintf("%s,%s", environmentKey(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccEnvironmentSlackChannelResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_slack_channel.test_channel", "channel_name", slackChannelName()),
+					resource.TestCheckResourceAttr("flagsmith_environment_slack_channel.test_channel", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentSlackChannelResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_environment_slack_channel.test_channel", "id")
+	if err != nil {
+		return err
+	}
+	configurationID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetEnvironmentSlackConfiguration(environmentKey(), configurationID)
+	if err == nil {
+		return fmt.Errorf("environment slack channel still exists")
+	}
+	return nil
+}
+
+func testAccEnvironmentSlackChannelResourceConfig(enabled bool) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_project_slack_integration" "test_slack" {
+  project_uuid = "%s"
+  api_token    = "%s"
+}
+
+resource "flagsmith_environment_slack_channel" "test_channel" {
+  environment_key = "%s"
+  channel_name    = "%s"
+  enabled         = %t
+
+  depends_on = [flagsmith_project_slack_integration.test_slack]
+}
+
+`, projectUUID(), slackAPIToken(), environmentKey(), slackChannelName(), enabled)
+}
@@ -3,9 +3,9 @@ package flagsmith_test
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"testing"
 	"strconv"
 )
@@ -32,6 +32,7 @@ func TestAccEnvironmentResource(t *testing.T) {
 					resource.TestCheckResourceAttr("flagsmith_environment.test_environment", "hide_sensitive_data", "false"),
 					resource.TestCheckResourceAttr("flagsmith_environment.test_environment", "allow_client_traits", "true"),
 					resource.TestCheckResourceAttr("flagsmith_environment.test_environment", "use_identity_composite_key_for_hashing", "true"),
+					resource.TestCheckResourceAttr("flagsmith_environment.test_environment", "use_v2_feature_versioning", "false"),
 
 					resource.TestCheckResourceAttrSet("flagsmith_environment.test_environment", "id"),
 					resource.TestCheckResourceAttrSet("flagsmith_environment.test_environment", "uuid"),
@@ -87,6 +88,47 @@ func TestAccEnvironmentResource(t *testing.T) {
 }
 
 
+func TestAccClonedEnvironmentResource(t *testing.T) {
+	environmentName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckClonedEnvironmentResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccClonedEnvironmentResourceConfig(environmentName, "cloned"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment.cloned_environment", "name", environmentName),
+					resource.TestCheckResourceAttr("flagsmith_environment.cloned_environment", "description", "cloned"),
+					resource.TestCheckResourceAttr("flagsmith_environment.cloned_environment", "clone_from_environment_key", environmentKey()),
+					resource.TestCheckResourceAttrSet("flagsmith_environment.cloned_environment", "id"),
+					resource.TestCheckResourceAttrSet("flagsmith_environment.cloned_environment", "uuid"),
+					resource.TestCheckResourceAttrSet("flagsmith_environment.cloned_environment", "api_key"),
+					testAccCheckClonedEnvironmentFeatureState,
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "flagsmith_environment.cloned_environment",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       getEnvironmentImportID("flagsmith_environment.cloned_environment"),
+				ImportStateVerifyIgnore: []string{"clone_from_environment_key"},
+			},
+			// Update testing, the environment is updated in place
+			{
+				Config: testAccClonedEnvironmentResourceConfig(environmentName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment.cloned_environment", "description", "updated"),
+					resource.TestCheckResourceAttr("flagsmith_environment.cloned_environment", "clone_from_environment_key", environmentKey()),
+				),
+			},
+		},
+	})
+}
+
 func getEnvironmentImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		return getAttributefromState(s, n, "uuid")
@@ -121,3 +163,42 @@ resource "flagsmith_environment" "test_environment" {
 
 `,environmentName, projectID, description)
 }
+
+// testAccCheckClonedEnvironmentFeatureState checks that the feature states of the source environment have been copied
+func testAccCheckClonedEnvironmentFeatureState(s *terraform.State) error {
+	apiKey, err := getAttributefromState(s, "flagsmith_environment.cloned_environment", "api_key")
+	if err != nil {
+		return err
+	}
+	_, err = testClient().GetEnvironmentFeatureState(apiKey, int64(featureID()))
+	return err
+}
+
+func testAccCheckClonedEnvironmentResourceDestroy(s *terraform.State) error {
+	apiKey, err := getAttributefromState(s, "flagsmith_environment.cloned_environment", "api_key")
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetEnvironment(apiKey)
+	if err == nil {
+		return fmt.Errorf("environment still exists")
+	}
+	return nil
+}
+
+func testAccClonedEnvironmentResourceConfig(environmentName string, description string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+
+}
+
+resource "flagsmith_environment" "cloned_environment" {
+  name                       = "%s"
+  project_id                 = %d
+  description                = "%s"
+  clone_from_environment_key = "%s"
+}
+
+`, environmentName, projectID(), description, environmentKey())
+}
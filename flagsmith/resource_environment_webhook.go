@@ -0,0 +1,195 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &environmentWebhookResource{}
+var _ resource.ResourceWithImportState = &environmentWebhookResource{}
+
+func newEnvironmentWebhookResource() resource.Resource {
+	return &environmentWebhookResource{}
+}
+
+type environmentWebhookResource struct {
+	client *Client
+}
+
+func (r *environmentWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_webhook"
+}
+
+func (r *environmentWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (t *environmentWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Environment Webhook: called every time a flag changes in the environment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the webhook",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side key of the environment",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URL the events are sent to",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the webhook is enabled. If unspecified, it will default to true",
+				Default:             booldefault.StaticBool(true),
+			},
+			"secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret used to sign the payload(HMAC), the signature is sent in the `X-Flagsmith-Signature` header",
+			},
+		},
+	}
+}
+
+func (r *environmentWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentWebhookResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientWebhook := data.ToClientWebhook()
+	err := r.client.CreateEnvironmentWebhook(data.EnvironmentKey.ValueString(), clientWebhook)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create environment webhook, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeEnvironmentWebhookResourceDataFromClientWebhook(clientWebhook, data.EnvironmentKey.ValueString(), data.Secret)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentWebhookResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	webhook, err := r.client.GetEnvironmentWebhook(data.EnvironmentKey.ValueString(), data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment webhook, got error: %s", err))
+		return
+	}
+	resourceData := MakeEnvironmentWebhookResourceDataFromClientWebhook(webhook, data.EnvironmentKey.ValueString(), data.Secret)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan EnvironmentWebhookResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientWebhook := plan.ToClientWebhook()
+	err := r.client.UpdateEnvironmentWebhook(plan.EnvironmentKey.ValueString(), clientWebhook)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update environment webhook, got error: %s", err))
+		return
+	}
+	resourceData := MakeEnvironmentWebhookResourceDataFromClientWebhook(clientWebhook, plan.EnvironmentKey.ValueString(), plan.Secret)
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *environmentWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state EnvironmentWebhookResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteEnvironmentWebhook(state.EnvironmentKey.ValueString(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete environment webhook, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *environmentWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_key,webhook_id Got: %q", req.ID),
+		)
+		return
+	}
+	webhookID, err := strconv.ParseInt(importKey[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("webhook_id must be an integer, got: %q", importKey[1]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), webhookID)...)
+}
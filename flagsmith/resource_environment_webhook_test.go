@@ -0,0 +1,87 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccEnvironmentWebhookResource(t *testing.T) {
+	path := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentWebhookResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentWebhookResourceConfig(path, true, "first-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_webhook.test_webhook", "environment_key", environmentKey()),
+					resource.TestCheckResourceAttr("flagsmith_environment_webhook.test_webhook", "url", "https://example.com/"+path),
+					resource.TestCheckResourceAttr("flagsmith_environment_webhook.test_webhook", "enabled", "true"),
+					resource.TestCheckResourceAttr("flagsmith_environment_webhook.test_webhook", "secret", "first-secret"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:            "flagsmith_environment_webhook.test_webhook",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_environment_webhook.test_webhook", "id")
+					return fmt.Sprintf("%s,%s", environmentKey(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccEnvironmentWebhookResourceConfig(path+"_updated", false, "second-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_environment_webhook.test_webhook", "url", "https://example.com/"+path+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_environment_webhook.test_webhook", "enabled", "false"),
+					resource.TestCheckResourceAttr("flagsmith_environment_webhook.test_webhook", "secret", "second-secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentWebhookResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_environment_webhook.test_webhook", "id")
+	if err != nil {
+		return err
+	}
+	webhookID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetEnvironmentWebhook(environmentKey(), webhookID)
+	if err == nil {
+		return fmt.Errorf("environment webhook still exists")
+	}
+	return nil
+}
+
+func testAccEnvironmentWebhookResourceConfig(path string, enabled bool, secret string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_environment_webhook" "test_webhook" {
+  environment_key = "%s"
+  url             = "https://example.com/%s"
+  enabled         = %t
+  secret          = "%s"
+}
+
+`, environmentKey(), path, enabled, secret)
+}
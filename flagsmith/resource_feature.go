@@ -23,13 +23,14 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &featureResource{}
 var _ resource.ResourceWithImportState = &featureResource{}
+var _ resource.ResourceWithModifyPlan = &featureResource{}
 
 func newFeatureResource() resource.Resource {
 	return &featureResource{}
 }
 
 type featureResource struct {
-	client *flagsmithapi.Client
+	client *Client
 }
 
 func (r *featureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,11 +43,11 @@ func (r *featureResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*flagsmithapi.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *flagsmithapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -125,10 +126,28 @@ func (t *featureResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
+			"metadata": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Metadata of the feature, keyed by metadata field name. The fields must be attached to features, see `flagsmith_metadata_model_field`",
+			},
 		},
 	}
 }
 
+func (r *featureResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if nothing changes
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var projectUUID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_uuid"), &projectUUID)...)
+	if resp.Diagnostics.HasError() || projectUUID.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(validatePlannedMetadata(ctx, r.client, req.Plan, projectUUID.ValueString(), 0, "feature")...)
+}
+
 func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FeatureResourceData
 
@@ -143,7 +162,16 @@ func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest
 	owners := clientFeature.Owners
 
 	// Create the feature
-	err := r.client.CreateFeature(clientFeature)
+	var err error
+	if data.Metadata == nil {
+		err = r.client.CreateFeature(clientFeature)
+	} else {
+		var metadata []Metadata
+		metadata, err = makeProjectClientMetadata(r.client, data.Metadata, data.ProjectUUID.ValueString(), 0, "feature")
+		if err == nil {
+			err = r.client.CreateFeatureWithMetadata(clientFeature, metadata)
+		}
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create feature, got error: %s", err))
@@ -160,6 +188,7 @@ func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest
 
 	clientFeature.Owners = owners
 	resourceData := MakeFeatureResourceDataFromClientFeature(clientFeature)
+	resourceData.Metadata = data.Metadata
 
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
@@ -191,6 +220,18 @@ func (r *featureResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	resourceData := MakeFeatureResourceDataFromClientFeature(feature)
 
+	// Metadata is only managed if set
+	if data.Metadata != nil {
+		metadata, err := r.client.GetFeatureMetadata(*feature.ProjectID, *feature.ID)
+		if err == nil {
+			resourceData.Metadata, err = makeProjectMetadataMap(r.client, metadata, *feature.ProjectID, "feature")
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature metadata, got error: %s", err))
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
 
@@ -220,7 +261,16 @@ func (r *featureResource) Update(ctx context.Context, req resource.UpdateRequest
 	clientFeature := plan.ToClientFeature()
 	planOwners := clientFeature.Owners
 
-	err := r.client.UpdateFeature(clientFeature)
+	var err error
+	if plan.Metadata == nil && state.Metadata == nil {
+		err = r.client.UpdateFeature(clientFeature)
+	} else {
+		var metadata []Metadata
+		metadata, err = makeProjectClientMetadata(r.client, plan.Metadata, plan.ProjectUUID.ValueString(), 0, "feature")
+		if err == nil {
+			err = r.client.UpdateFeatureWithMetadata(clientFeature, metadata)
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update feature, got error: %s", err))
 		return
@@ -248,6 +298,7 @@ func (r *featureResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 	clientFeature.Owners = planOwners
 	resourceData := MakeFeatureResourceDataFromClientFeature(clientFeature)
+	resourceData.Metadata = plan.Metadata
 
 	// Update the state with the new values
 	diags = resp.State.Set(ctx, &resourceData)
@@ -0,0 +1,227 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &featureExportResource{}
+var _ resource.ResourceWithImportState = &featureExportResource{}
+
+func newFeatureExportResource() resource.Resource {
+	return &featureExportResource{}
+}
+
+type featureExportResource struct {
+	client *Client
+}
+
+func (r *featureExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_export"
+}
+
+func (r *featureExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *featureExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Feature Export: exports the features of the project of an environment, along with their values " +
+			"in the environment, to JSON. The export is made on create and waits for it to be processed, see `flagsmith_feature_import` to import it. " +
+			"Flagsmith does not delete feature exports, destroying the resource only removes it from the state",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the feature export",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side environment key of the environment to export the feature values of",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"tag_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the tags to restrict the export to, i.e: only the features that have one of the tags are exported",
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the feature export",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the feature export",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date the feature export was created at",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"content": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "JSON of the exported features",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *featureExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeatureExportResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(data.EnvironmentKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+	featureExport, err := r.client.CreateFeatureExport(environment.ID, data.ToClientTagIDs())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create feature export, got error: %s", err))
+		return
+	}
+	resourceData := MakeFeatureExportResourceDataFromClientFeatureExport(featureExport)
+	resourceData.EnvironmentKey = data.EnvironmentKey
+	resourceData.TagIDs = data.TagIDs
+
+	// Save the export first, so that it's tainted(i.e: exported again) if processing or downloading fails
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+
+	featureExport, err = r.client.WaitForFeatureExport(ctx, environment.ProjectID, featureExport.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export features, got error: %s", err))
+		return
+	}
+	resourceData.Status = MakeFeatureExportResourceDataFromClientFeatureExport(featureExport).Status
+	content, err := r.client.DownloadFeatureExport(featureExport.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to download feature export, got error: %s", err))
+		return
+	}
+	resourceData.Content = types.StringValue(content)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeatureExportResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(data.EnvironmentKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+	featureExport, err := r.client.GetFeatureExport(environment.ProjectID, data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature export, got error: %s", err))
+		return
+	}
+	resourceData := MakeFeatureExportResourceDataFromClientFeatureExport(featureExport)
+	resourceData.EnvironmentKey = data.EnvironmentKey
+	resourceData.TagIDs = data.TagIDs
+	resourceData.Content = data.Content
+
+	// The content is only downloaded once, i.e: on create or import, and only once processed, as a failed
+	// export is kept tainted without content
+	if data.Content.IsNull() && featureExport.Status == "SUCCESS" {
+		content, err := r.client.DownloadFeatureExport(featureExport.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to download feature export, got error: %s", err))
+			return
+		}
+		resourceData.Content = types.StringValue(content)
+	}
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replace, feature exports can not be updated
+	var plan FeatureExportResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Feature exports can not be deleted, only remove it from the state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *featureExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_key,feature_export_id Got: %q", req.ID),
+		)
+		return
+	}
+	featureExportID, err := strconv.ParseInt(importKey[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("feature_export_id must be an integer, got: %q", importKey[1]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), featureExportID)...)
+}
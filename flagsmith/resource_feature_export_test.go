@@ -0,0 +1,61 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFeatureExportResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFeatureExportResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_feature_export.test_export", "environment_key", environmentKey()),
+					resource.TestCheckResourceAttr("flagsmith_feature_export.test_export", "status", "SUCCESS"),
+					resource.TestCheckResourceAttrSet("flagsmith_feature_export.test_export", "id"),
+					resource.TestCheckResourceAttrSet("flagsmith_feature_export.test_export", "name"),
+					resource.TestCheckResourceAttrSet("flagsmith_feature_export.test_export", "created_at"),
+					resource.TestCheckResourceAttrSet("flagsmith_feature_export.test_export", "content"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_feature_export.test_export",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getFeatureExportImportID("flagsmith_feature_export.test_export"),
+			},
+		},
+	})
+}
+
+func getFeatureExportImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		id, err := getAttributefromState(s, n, "id")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s,%s", environmentKey(), id), nil
+	}
+}
+
+func testAccFeatureExportResourceConfig() string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+
+}
+
+resource "flagsmith_feature_export" "test_export" {
+  environment_key = "%s"
+}
+
+`, environmentKey())
+}
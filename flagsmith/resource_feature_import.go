@@ -0,0 +1,184 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &featureImportResource{}
+
+func newFeatureImportResource() resource.Resource {
+	return &featureImportResource{}
+}
+
+type featureImportResource struct {
+	client *Client
+}
+
+func (r *featureImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_import"
+}
+
+func (r *featureImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *featureImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Feature Import: imports the JSON of a feature export(see `flagsmith_feature_export`) into an environment. " +
+			"The import is made on create and waits for it to be processed, changing any attribute imports the features again. " +
+			"Destroying the resource only removes it from the state, the imported features are left as they are",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the feature import",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side environment key of the environment to import the features into",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"strategy": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "How features that already exist are handled, can be `SKIP`(i.e: they are left as they are) or " +
+					"`OVERWRITE_DESTRUCTIVE`(i.e: they are overwritten with the imported values)",
+				Validators:    []validator.String{stringvalidator.OneOf(featureImportStrategies...)},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "JSON of the features to import, e.g: the `content` of a `flagsmith_feature_export`",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the feature import",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date the feature import was created at",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *featureImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeatureImportResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(data.EnvironmentKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+	featureImport, err := r.client.CreateFeatureImport(environment.ID, data.Strategy.ValueString(), data.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create feature import, got error: %s", err))
+		return
+	}
+	resourceData := MakeFeatureImportResourceDataFromClientFeatureImport(featureImport)
+	resourceData.EnvironmentKey = data.EnvironmentKey
+	resourceData.Content = data.Content
+
+	// Save the import first, so that it's tainted(i.e: imported again) if processing fails
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+
+	featureImport, err = r.client.WaitForFeatureImport(ctx, environment.ProjectID, featureImport.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import features, got error: %s", err))
+		return
+	}
+	resourceData.Status = MakeFeatureImportResourceDataFromClientFeatureImport(featureImport).Status
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeatureImportResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(data.EnvironmentKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+	featureImport, err := r.client.GetFeatureImport(environment.ProjectID, data.ID.ValueInt64())
+	if err != nil {
+		// The features have been imported regardless, keep the state instead of importing them again
+		if _, ok := err.(NotFoundError); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature import, got error: %s", err))
+		return
+	}
+	resourceData := MakeFeatureImportResourceDataFromClientFeatureImport(featureImport)
+	resourceData.EnvironmentKey = data.EnvironmentKey
+	resourceData.Content = data.Content
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replace, feature imports can not be updated
+	var plan FeatureImportResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Feature imports can not be undone, only remove it from the state
+	resp.State.RemoveResource(ctx)
+}
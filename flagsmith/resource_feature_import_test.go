@@ -0,0 +1,69 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeatureImportResource(t *testing.T) {
+	environmentName := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test strategy validator
+			{
+				Config:      testAccFeatureImportResourceConfig(environmentName, "MERGE"),
+				ExpectError: regexp.MustCompile(`Attribute strategy value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccFeatureImportResourceConfig(environmentName, "SKIP"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_feature_import.test_import", "strategy", "SKIP"),
+					resource.TestCheckResourceAttr("flagsmith_feature_import.test_import", "status", "SUCCESS"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_import.test_import", "environment_key", "flagsmith_environment.test_import", "api_key"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_import.test_import", "content", "flagsmith_feature_export.test_import", "content"),
+					resource.TestCheckResourceAttrSet("flagsmith_feature_import.test_import", "id"),
+					resource.TestCheckResourceAttrSet("flagsmith_feature_import.test_import", "created_at"),
+				),
+			},
+			// Changing the strategy imports the features again
+			{
+				Config: testAccFeatureImportResourceConfig(environmentName, "OVERWRITE_DESTRUCTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_feature_import.test_import", "strategy", "OVERWRITE_DESTRUCTIVE"),
+					resource.TestCheckResourceAttr("flagsmith_feature_import.test_import", "status", "SUCCESS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFeatureImportResourceConfig(environmentName, strategy string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+
+}
+
+resource "flagsmith_feature_export" "test_import" {
+  environment_key = "%s"
+}
+
+resource "flagsmith_environment" "test_import" {
+  name       = "%s"
+  project_id = %d
+}
+
+resource "flagsmith_feature_import" "test_import" {
+  environment_key = flagsmith_environment.test_import.api_key
+  strategy        = "%s"
+  content         = flagsmith_feature_export.test_import.content
+}
+
+`, environmentKey(), environmentName, projectID(), strategy)
+}
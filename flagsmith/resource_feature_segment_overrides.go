@@ -0,0 +1,262 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &featureSegmentOverridesResource{}
+var _ resource.ResourceWithImportState = &featureSegmentOverridesResource{}
+var _ resource.ResourceWithValidateConfig = &featureSegmentOverridesResource{}
+
+func newFeatureSegmentOverridesResource() resource.Resource {
+	return &featureSegmentOverridesResource{}
+}
+
+type featureSegmentOverridesResource struct {
+	client *Client
+}
+
+func (r *featureSegmentOverridesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_segment_overrides"
+}
+
+func (r *featureSegmentOverridesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *featureSegmentOverridesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Feature Segment Overrides: manages every segment override of a feature in an environment, " +
+			"including their priorities. Overrides that are not listed are removed, hence the segment overrides of the feature " +
+			"should not be managed using `flagsmith_feature_state` as well. Environments using v2 feature versioning should " +
+			"use `flagsmith_environment_feature_version` instead",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side environment key associated with the environment",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"feature_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the feature",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"segment_overrides": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Segment overrides of the feature, in order of priority(i.e: the first one has the highest priority)",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"segment_id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "ID of the segment",
+						},
+						"enabled": schema.BoolAttribute{
+							Required:            true,
+							MarkdownDescription: "Used for enabling/disabling the feature for the segment",
+						},
+						"feature_state_value": requiredFeatureStateValueAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *featureSegmentOverridesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateUniqueSegmentOverrides(ctx, req.Config)...)
+}
+
+// read returns the resource data of the live segment overrides
+func (r *featureSegmentOverridesResource) read(environmentKey string, featureID int64) (*FeatureSegmentOverridesResourceData, error) {
+	overrides, err := r.client.GetSegmentOverrides(environmentKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	resourceData := MakeFeatureSegmentOverridesResourceDataFromClientFS(environmentKey, featureID, overrides)
+	return &resourceData, nil
+}
+
+// apply creates, updates and deletes segment overrides to match the plan, then sets every priority at once
+func (r *featureSegmentOverridesResource) apply(plan FeatureSegmentOverridesResourceData) (*FeatureSegmentOverridesResourceData, error) {
+	environmentKey := plan.EnvironmentKey.ValueString()
+	featureID := plan.Feature.ValueInt64()
+	v2, err := r.client.UsesV2FeatureVersioning(environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	if v2 {
+		return nil, fmt.Errorf("environment %q uses v2 feature versioning, use `flagsmith_environment_feature_version` instead", environmentKey)
+	}
+
+	liveOverrides, err := r.client.GetSegmentOverrides(environmentKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	changes := plan.ToClientSegmentOverrideChanges(liveOverrides)
+	for _, featureSegmentID := range changes.FeatureSegmentsToDelete {
+		err = r.client.DeleteFeatureSegment(featureSegmentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, featureState := range changes.FeatureStatesToUpdate {
+		err = r.client.UpdateFeatureState(featureState, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, featureState := range changes.FeatureStatesToCreate {
+		err = r.client.CreateSegmentOverride(featureState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	overrides, err := r.client.GetSegmentOverrides(environmentKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	priorities := plan.ToClientFeatureSegmentPriorities(overrides)
+	if len(priorities) > 0 {
+		err = r.client.UpdateFeatureSegmentPriorities(priorities)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r.read(environmentKey, featureID)
+}
+
+func (r *featureSegmentOverridesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeatureSegmentOverridesResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceData, err := r.apply(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create feature segment overrides, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureSegmentOverridesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeatureSegmentOverridesResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	resourceData, err := r.read(data.EnvironmentKey.ValueString(), data.Feature.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature segment overrides, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureSegmentOverridesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan FeatureSegmentOverridesResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	resourceData, err := r.apply(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update feature segment overrides, got error: %s", err))
+		return
+	}
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *featureSegmentOverridesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state FeatureSegmentOverridesResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	// Every segment override of the feature is removed, including the ones created since the last refresh
+	overrides, err := r.client.GetSegmentOverrides(state.EnvironmentKey.ValueString(), state.Feature.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature segment overrides, got error: %s", err))
+		return
+	}
+	for _, featureState := range overrides {
+		err = r.client.DeleteFeatureSegment(*featureState.FeatureSegment)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete feature segment, got error: %s", err))
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *featureSegmentOverridesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_key,feature_id Got: %q", req.ID),
+		)
+		return
+	}
+	featureID, err := strconv.ParseInt(importKey[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("feature_id must be an integer, got: %q", importKey[1]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature_id"), featureID)...)
+}
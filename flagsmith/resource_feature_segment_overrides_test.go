@@ -0,0 +1,135 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccFeatureSegmentOverridesResource(t *testing.T) {
+	name := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFeatureSegmentOverridesResourceConfig(name, "value_one", `[flagsmith_segment.first.id, flagsmith_segment.second.id, flagsmith_segment.third.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "feature_id", "flagsmith_feature.test_feature", "id"),
+					resource.TestCheckResourceAttr("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.#", "3"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.0.segment_id", "flagsmith_segment.first", "id"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.1.segment_id", "flagsmith_segment.second", "id"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.2.segment_id", "flagsmith_segment.third", "id"),
+					resource.TestCheckResourceAttr("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.0.feature_state_value.string_value", "value_one"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_feature_segment_overrides.test_overrides",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					environmentKey, err := getAttributefromState(s, "flagsmith_feature_segment_overrides.test_overrides", "environment_key")
+					if err != nil {
+						return "", err
+					}
+					featureID, err := getAttributefromState(s, "flagsmith_feature_segment_overrides.test_overrides", "feature_id")
+					if err != nil {
+						return "", err
+					}
+					return fmt.Sprintf("%s,%s", environmentKey, featureID), nil
+				},
+			},
+
+			// Update testing: reorder, update and remove overrides in one apply
+			{
+				Config: testAccFeatureSegmentOverridesResourceConfig(name, "value_two", `[flagsmith_segment.third.id, flagsmith_segment.first.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.#", "2"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.0.segment_id", "flagsmith_segment.third", "id"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.1.segment_id", "flagsmith_segment.first", "id"),
+					resource.TestCheckResourceAttr("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.1.feature_state_value.string_value", "value_two"),
+				),
+			},
+
+			// Update testing: add an override with the highest priority
+			{
+				Config: testAccFeatureSegmentOverridesResourceConfig(name, "value_two", `[flagsmith_segment.second.id, flagsmith_segment.third.id, flagsmith_segment.first.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.#", "3"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.0.segment_id", "flagsmith_segment.second", "id"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_segment_overrides.test_overrides", "segment_overrides.2.segment_id", "flagsmith_segment.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFeatureSegmentOverridesResourceConfig(name, value, segmentIDs string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_feature" "test_feature" {
+  feature_name = "%[1]s"
+  project_uuid = "%[2]s"
+  type         = "STANDARD"
+}
+
+resource "flagsmith_segment" "first" {
+  name         = "%[1]s_first"
+  project_uuid = "%[2]s"
+  rules = [{
+    type = "ALL"
+    rules = [{
+      type       = "ANY"
+      conditions = [{ operator = "EQUAL", property = "device_type", value = "mobile" }]
+    }]
+  }]
+}
+
+resource "flagsmith_segment" "second" {
+  name         = "%[1]s_second"
+  project_uuid = "%[2]s"
+  rules = [{
+    type = "ALL"
+    rules = [{
+      type       = "ANY"
+      conditions = [{ operator = "EQUAL", property = "device_type", value = "desktop" }]
+    }]
+  }]
+}
+
+resource "flagsmith_segment" "third" {
+  name         = "%[1]s_third"
+  project_uuid = "%[2]s"
+  rules = [{
+    type = "ALL"
+    rules = [{
+      type       = "ANY"
+      conditions = [{ operator = "EQUAL", property = "device_type", value = "tablet" }]
+    }]
+  }]
+}
+
+resource "flagsmith_feature_segment_overrides" "test_overrides" {
+  environment_key = "%[3]s"
+  feature_id      = flagsmith_feature.test_feature.id
+  segment_overrides = [for segment_id in %[5]s : {
+    segment_id = segment_id
+    enabled    = true
+    feature_state_value = {
+      type         = "unicode"
+      string_value = "%[4]s"
+    }
+  }]
+}
+
+`, name, projectUUID(), environmentKey(), value, segmentIDs)
+}
@@ -12,26 +12,32 @@ import (
 	"github.com/Flagsmith/flagsmith-go-api-client"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &featureStateResource{}
 var _ resource.ResourceWithImportState = &featureStateResource{}
+var _ resource.ResourceWithModifyPlan = &featureStateResource{}
 
 func newFeatureStateResource() resource.Resource {
 	return &featureStateResource{}
 }
 
 type featureStateResource struct {
-	client *flagsmithapi.Client
+	client *Client
 }
 
 func (r *featureStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,11 +50,11 @@ func (r *featureStateResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*flagsmithapi.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *flagsmithapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -139,6 +145,75 @@ func (t *featureStateResource) Schema(ctx context.Context, req resource.SchemaRe
 
 				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
+			"change_request": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "If set, changes to the feature state are made through a change request instead of being applied directly, " +
+					"e.g: for environments with `minimum_change_request_approvals`. NOTE: creating a segment override is not covered",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "Title of the change request",
+						Required:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Description of the change request",
+						Optional:            true,
+					},
+					"approver_user_ids": schema.SetAttribute{
+						MarkdownDescription: "IDs of the users assigned to approve the change request",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+					},
+					"approver_group_ids": schema.SetAttribute{
+						MarkdownDescription: "IDs of the user groups assigned to approve the change request",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+					},
+					"wait_for_approval": schema.BoolAttribute{
+						MarkdownDescription: "Wait for the change request to be approved and commit it. Otherwise, apply finishes with a warning " +
+							"and the change request is left pending. If unspecified, it will default to false",
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: "How long to wait for the approval if `wait_for_approval` is set, e.g: `1h`. If unspecified, it will default to `30m`",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("30m"),
+						Validators:          []validator.String{durationValidator{}},
+					},
+				},
+			},
+			"live_from": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp(e.g: `2024-01-02T09:00:00Z`) the changes go live at. If set, changes are scheduled " +
+					"instead of being applied immediately and must be in the future. NOTE: creating a segment override is not covered",
+				Optional:   true,
+				Validators: []validator.String{rfc3339Validator{}},
+			},
+			"pending_change_request_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the change request waiting for approval, if any. The feature state is reconciled on refresh once the change request is committed or deleted",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"multivariate_values": schema.ListNestedAttribute{
+				MarkdownDescription: "Percentage allocations of the multivariate options of the feature in this environment(or segment override), " +
+					"they must add up to at most 100. Options that are not listed are set to 0. If unset, the percentage allocations are left as they are",
+				Optional:   true,
+				Validators: []validator.List{multivariateValuesValidator{}},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"multivariate_option_uuid": schema.StringAttribute{
+							MarkdownDescription: "UUID of the multivariate option",
+							Required:            true,
+						},
+						"percentage_allocation": schema.Float64Attribute{
+							MarkdownDescription: "Percentage of the identities that get the multivariate option",
+							Required:            true,
+							Validators:          []validator.Float64{float64validator.Between(0, 100)},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -153,6 +228,59 @@ func (f *featureStateResource) ConfigValidators(ctx context.Context) []resource.
     }
 }
 
+func (r *featureStateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if nothing changes
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var changeRequest types.Object
+	var liveFrom types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("change_request"), &changeRequest)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("live_from"), &liveFrom)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		var segment types.Int64
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("segment_id"), &segment)...)
+		if !segment.IsNull() && !changeRequest.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("change_request"),
+				"Invalid Attribute Combination",
+				"`change_request` can not be used to create a segment override, create it first and make the changes through a change request afterwards",
+			)
+			return
+		}
+		if !segment.IsNull() && !liveFrom.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("live_from"),
+				"Invalid Attribute Combination",
+				"`live_from` can not be used to create a segment override, create it first and schedule the changes afterwards",
+			)
+			return
+		}
+	}
+	// The pending change request kept from the state does not survive a change: changes made directly
+	// clear it, the others open a new one
+	pendingChangeRequestID := types.Int64Unknown()
+	if changeRequest.IsNull() && liveFrom.IsNull() {
+		pendingChangeRequestID = types.Int64Null()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_change_request_id"), pendingChangeRequestID)...)
+	if liveFrom.IsNull() || liveFrom.IsUnknown() {
+		return
+	}
+	timestamp, err := time.Parse(time.RFC3339, liveFrom.ValueString())
+	// The format is checked by the attribute validator
+	if err == nil && !timestamp.After(time.Now()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("live_from"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("`live_from` must be in the future to schedule the changes, got: %q", liveFrom.ValueString()),
+		)
+	}
+}
+
 func (r *featureStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FeatureStateResourceData
 
@@ -170,8 +298,19 @@ func (r *featureStateResource) Create(ctx context.Context, req resource.CreateRe
 			resp.Diagnostics.AddError("Error creating segment override", err.Error())
 			return
 		}
+		err = r.updateMultivariateValues(data, clientFeatureState.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update multivariate values, got error: %s", err))
+			return
+		}
 		// set the state with the new values
 		resourceData := MakeFeatureStateResourceDataFromClientFS(clientFeatureState)
+		err = r.readMultivariateValues(&resourceData, data.MultivariateValues)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read multivariate values, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("change_request"), &resourceData.ChangeRequest)...)
 		diags = resp.State.Set(ctx, &resourceData)
 		resp.Diagnostics.Append(diags...)
 		return
@@ -222,10 +361,33 @@ func (r *featureStateResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	// pending_change_request_id is only unknown when reading the plan on create
+	if !data.PendingChangeRequestID.IsUnknown() {
+		pending, err := r.isChangePending(data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read change request, got error: %s", err))
+			return
+		}
+		// Keep the desired values until the change is live, i.e: no drift is reported while it's pending
+		if pending {
+			diags = resp.State.Set(ctx, &data)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	var featureState *flagsmithapi.FeatureState
 	var err error
 
-	if data.UUID.ValueString() != "" {
+	if data.PendingChangeRequestID.ValueInt64() != 0 || !data.LiveFrom.IsNull() {
+		// Change requests and scheduled changes replace the feature state
+		var featureSegment *int64
+		if data.FeatureSegment.ValueInt64() != 0 {
+			featureSegmentID := data.FeatureSegment.ValueInt64()
+			featureSegment = &featureSegmentID
+		}
+		featureState, err = r.client.GetLiveFeatureState(data.EnvironmentKey.ValueString(), data.Feature.ValueInt64(), featureSegment)
+	} else if data.UUID.ValueString() != "" {
 		featureState, err = r.client.GetFeatureState(data.UUID.ValueString())
 
 	} else {
@@ -236,17 +398,55 @@ func (r *featureStateResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		panic(err)
-
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature state, got error: %s", err))
+		return
 	}
 	resourceData := MakeFeatureStateResourceDataFromClientFS(featureState)
 
 	resourceData.EnvironmentKey = data.EnvironmentKey
+	resourceData.ChangeRequest = data.ChangeRequest
+	resourceData.LiveFrom = data.LiveFrom
+	err = r.readMultivariateValues(&resourceData, data.MultivariateValues)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read multivariate values, got error: %s", err))
+		return
+	}
 
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
 }
 
+// isChangePending reports whether the last change made to the feature state is not live yet, i.e: its change
+// request has not been committed(nor deleted) or it is scheduled in the future
+func (r *featureStateResource) isChangePending(data FeatureStateResourceData) (bool, error) {
+	if data.PendingChangeRequestID.ValueInt64() != 0 {
+		changeRequest, err := r.client.GetChangeRequest(data.PendingChangeRequestID.ValueInt64())
+		if err != nil {
+			if _, ok := err.(NotFoundError); ok {
+				return false, nil
+			}
+			return false, err
+		}
+		if changeRequest.CommittedAt == nil {
+			return true, nil
+		}
+	}
+	return isFutureTimestamp(data.LiveFrom), nil
+}
+
+// isFutureTimestamp reports whether value is a RFC3339 timestamp in the future
+func isFutureTimestamp(value types.String) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	timestamp, err := time.Parse(time.RFC3339, value.ValueString())
+	return err == nil && timestamp.After(time.Now())
+}
+
 func (r *featureStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan FeatureStateResourceData
@@ -265,6 +465,11 @@ func (r *featureStateResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if plan.ChangeRequest != nil || !plan.LiveFrom.IsNull() {
+		r.updateWithChangeRequest(ctx, plan, state, resp)
+		return
+	}
+
 	// Generate API request body from plan
 	clientFeatureState := plan.ToClientFS()
 
@@ -278,17 +483,227 @@ func (r *featureStateResource) Update(ctx context.Context, req resource.UpdateRe
 	err := r.client.UpdateFeatureState(clientFeatureState, updateSegmentPriority)
 
 	if err != nil {
+		// Feature states of v2 environments can only be changed by publishing a new version
+		if v2, _ := r.client.UsesV2FeatureVersioning(plan.EnvironmentKey.ValueString()); v2 {
+			resp.Diagnostics.AddError(
+				"Environment Uses V2 Feature Versioning",
+				fmt.Sprintf("Feature states of environment %q can not be updated individually, use `flagsmith_environment_feature_version` instead. Got error: %s", plan.EnvironmentKey.ValueString(), err),
+			)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update feature state, got error: %s", err))
 		return
 	}
+	err = r.updateMultivariateValues(plan, clientFeatureState.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update multivariate values, got error: %s", err))
+		return
+	}
 	resourceData := MakeFeatureStateResourceDataFromClientFS(clientFeatureState)
 	resourceData.EnvironmentKey = plan.EnvironmentKey
+	resourceData.ChangeRequest = plan.ChangeRequest
+	resourceData.LiveFrom = plan.LiveFrom
+	err = r.readMultivariateValues(&resourceData, plan.MultivariateValues)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read multivariate values, got error: %s", err))
+		return
+	}
 
 	// Update the state with the new values
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
 }
 
+// multivariateOptions returns the multivariate options of the feature of the feature state
+func (r *featureStateResource) multivariateOptions(data FeatureStateResourceData) ([]flagsmithapi.FeatureMultivariateOption, error) {
+	environment, err := r.client.GetEnvironment(data.EnvironmentKey.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return r.client.GetFeatureMVOptions(environment.ProjectID, data.Feature.ValueInt64())
+}
+
+// updateMultivariateValues sets the percentage allocations of the multivariate options on the feature state,
+// unless `multivariate_values` is unset
+func (r *featureStateResource) updateMultivariateValues(data FeatureStateResourceData, featureStateID int64) error {
+	if data.MultivariateValues == nil {
+		return nil
+	}
+	options, err := r.multivariateOptions(data)
+	if err != nil {
+		return err
+	}
+	current, err := r.client.GetMultivariateFeatureStateValues(featureStateID)
+	if err != nil {
+		return err
+	}
+	values, err := data.ToClientMultivariateFeatureStateValues(options, current)
+	if err != nil {
+		return err
+	}
+	return r.client.UpdateMultivariateFeatureStateValues(featureStateID, values)
+}
+
+// readMultivariateValues loads the percentage allocations of the multivariate options of the feature state into
+// resourceData, unless `multivariate_values` is unset(i.e: prior is nil)
+func (r *featureStateResource) readMultivariateValues(resourceData *FeatureStateResourceData, prior []MultivariateValue) error {
+	if prior == nil {
+		return nil
+	}
+	options, err := r.multivariateOptions(*resourceData)
+	if err != nil {
+		return err
+	}
+	values, err := r.client.GetMultivariateFeatureStateValues(resourceData.ID.ValueInt64())
+	if err != nil {
+		return err
+	}
+	resourceData.MultivariateValues = MakeMultivariateValuesFromClient(values, options, prior)
+	return nil
+}
+
+// changeRequestPollInterval is the interval at which a change request is checked while waiting for approval
+var changeRequestPollInterval = 10 * time.Second
+
+// updateWithChangeRequest opens a change request with the planned values instead of updating the feature state.
+// If `wait_for_approval` is set, the change request is committed once approved, otherwise(or on timeout) the
+// planned values are saved along with the ID of the pending change request. Scheduled changes(`live_from`)
+// without a `change_request` block are committed straight away
+func (r *featureStateResource) updateWithChangeRequest(ctx context.Context, plan, state FeatureStateResourceData, resp *resource.UpdateResponse) {
+	// Load computed data from the state
+	plan.ID = state.ID
+	plan.UUID = state.UUID
+	plan.Feature = state.Feature
+	plan.Environment = state.Environment
+	plan.FeatureSegment = state.FeatureSegment
+	if plan.SegmentPriority.IsUnknown() {
+		plan.SegmentPriority = state.SegmentPriority
+	}
+
+	changeRequest := plan.ToClientChangeRequest()
+	if plan.MultivariateValues != nil {
+		options, err := r.multivariateOptions(plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read multivariate options, got error: %s", err))
+			return
+		}
+		// The change request creates a new feature state, hence there are no current values to update
+		values, err := plan.ToClientMultivariateFeatureStateValues(options, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Multivariate Values", err.Error())
+			return
+		}
+		changeRequest.FeatureStates[0].MultivariateFeatureStateValues = values
+	}
+	err := r.client.CreateChangeRequest(plan.EnvironmentKey.ValueString(), changeRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create change request, got error: %s", err))
+		return
+	}
+	changeRequestID := *changeRequest.ID
+	plan.PendingChangeRequestID = types.Int64Value(changeRequestID)
+
+	// Save the pending change request first, so that it's reconciled on refresh if anything below fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.ChangeRequest == nil:
+		// Scheduled change without approval process
+		err = r.client.CommitChangeRequest(changeRequestID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to commit change request %d, set `change_request` if the environment requires approvals, got error: %s", changeRequestID, err),
+			)
+			return
+		}
+	case !plan.ChangeRequest.WaitForApproval.ValueBool():
+		resp.Diagnostics.AddWarning(
+			"Change Request Pending",
+			fmt.Sprintf("Change request %d has been created and is waiting for approval, the feature state will be updated once it is committed", changeRequestID),
+		)
+		return
+	default:
+		if !r.waitForChangeRequest(ctx, changeRequestID, plan, resp) {
+			return
+		}
+	}
+	plan.PendingChangeRequestID = types.Int64Null()
+
+	// The scheduled change is not live yet, keep the planned values until it is
+	if isFutureTimestamp(plan.LiveFrom) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	featureState, err := r.client.GetLiveFeatureState(plan.EnvironmentKey.ValueString(), plan.Feature.ValueInt64(), plan.ToClientFS().FeatureSegment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature state, got error: %s", err))
+		return
+	}
+	resourceData := MakeFeatureStateResourceDataFromClientFS(featureState)
+	resourceData.EnvironmentKey = plan.EnvironmentKey
+	resourceData.ChangeRequest = plan.ChangeRequest
+	resourceData.LiveFrom = plan.LiveFrom
+	err = r.readMultivariateValues(&resourceData, plan.MultivariateValues)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read multivariate values, got error: %s", err))
+		return
+	}
+
+	diags := resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+// waitForChangeRequest waits for the change request to be approved and commits it, unless it has
+// already been committed. It returns false if the change request was not committed
+func (r *featureStateResource) waitForChangeRequest(ctx context.Context, changeRequestID int64, plan FeatureStateResourceData, resp *resource.UpdateResponse) bool {
+	environment, err := r.client.GetEnvironment(plan.EnvironmentKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return false
+	}
+
+	// The timeout has already been validated
+	timeout, _ := time.ParseDuration(plan.ChangeRequest.Timeout.ValueString())
+	deadline := time.Now().Add(timeout)
+	for {
+		changeRequest, err := r.client.GetChangeRequest(changeRequestID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read change request, got error: %s", err))
+			return false
+		}
+		if changeRequest.CommittedAt != nil {
+			return true
+		}
+		if changeRequest.ApprovalCount() >= environment.MinimumChangeRequestApprovals {
+			err = r.client.CommitChangeRequest(changeRequestID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit change request, got error: %s", err))
+				return false
+			}
+			return true
+		}
+		if time.Now().After(deadline) {
+			resp.Diagnostics.AddError(
+				"Change Request Not Approved",
+				fmt.Sprintf("Change request %d was not approved within %s, it is left pending and will be reconciled on refresh", changeRequestID, timeout),
+			)
+			return false
+		}
+		tflog.Debug(ctx, "Waiting for change request approval", map[string]interface{}{"change_request_id": changeRequestID})
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Change Request Not Approved", fmt.Sprintf("Stopped waiting for change request %d: %s", changeRequestID, ctx.Err()))
+			return false
+		case <-time.After(changeRequestPollInterval):
+		}
+	}
+}
+
 func (r *featureStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Get current state
 	var state FeatureStateResourceData
@@ -3,10 +3,10 @@ package flagsmith_test
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"regexp"
 	"strconv"
 	"testing"
@@ -70,6 +70,16 @@ func TestAccEnvironmentFeatureStateResource(t *testing.T) {
 					resource.TestCheckResourceAttr("flagsmith_feature_state.dummy_environment_feature_x", "enabled", "false"),
 				),
 			},
+
+			// Scheduled changes must be in the future
+			{
+				Config:      testAccScheduledFeatureStateResourceConfig("three", true, "2020-01-01T09:00:00Z"),
+				ExpectError: regexp.MustCompile("`live_from` must be in the future to schedule the changes"),
+			},
+			{
+				Config:      testAccScheduledFeatureStateResourceConfig("three", true, "tomorrow"),
+				ExpectError: regexp.MustCompile(`Expected a RFC3339 timestamp`),
+			},
 		},
 	})
 }
@@ -80,6 +90,12 @@ func TestAccSegmentFeatureStateResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Segment overrides can not be created through a change request
+			{
+				Config:      testAccSegmentFeatureStateWithChangeRequestConfig(),
+				ExpectError: regexp.MustCompile("`change_request` can not be used to create a segment override"),
+			},
+
 			// Create and Read testing
 			{
 				Config: testAccSegmentFeatureStateResourceConfig("one", featureName, true, 0),
@@ -134,6 +150,45 @@ func TestAccSegmentFeatureStateResource(t *testing.T) {
 	})
 }
 
+func TestAccMultivariateFeatureStateResource(t *testing.T) {
+	featureName := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test percentage allocations validator
+			{
+				Config:      testAccMultivariateFeatureStateResourceConfig(featureName, 60, 50),
+				ExpectError: regexp.MustCompile(`Percentage allocations of the multivariate options must add up to at most\s+100`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccMultivariateFeatureStateResourceConfig(featureName, 30, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_feature_state.multivariate_environment", "multivariate_values.#", "2"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_state.multivariate_environment", "multivariate_values.0.multivariate_option_uuid", "flagsmith_mv_feature_option.option_a", "uuid"),
+					resource.TestCheckResourceAttr("flagsmith_feature_state.multivariate_environment", "multivariate_values.0.percentage_allocation", "30"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_state.multivariate_environment", "multivariate_values.1.multivariate_option_uuid", "flagsmith_mv_feature_option.option_b", "uuid"),
+					resource.TestCheckResourceAttr("flagsmith_feature_state.multivariate_environment", "multivariate_values.1.percentage_allocation", "70"),
+
+					resource.TestCheckResourceAttr("flagsmith_feature_state.multivariate_segment_override", "multivariate_values.#", "1"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_state.multivariate_segment_override", "multivariate_values.0.multivariate_option_uuid", "flagsmith_mv_feature_option.option_b", "uuid"),
+					resource.TestCheckResourceAttr("flagsmith_feature_state.multivariate_segment_override", "multivariate_values.0.percentage_allocation", "100"),
+				),
+			},
+			// Update testing, option a is no longer listed hence it is set to 0
+			{
+				Config: testAccMultivariateFeatureStateResourceConfig(featureName, 0, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_feature_state.multivariate_environment", "multivariate_values.#", "1"),
+					resource.TestCheckResourceAttrPair("flagsmith_feature_state.multivariate_environment", "multivariate_values.0.multivariate_option_uuid", "flagsmith_mv_feature_option.option_b", "uuid"),
+					resource.TestCheckResourceAttr("flagsmith_feature_state.multivariate_environment", "multivariate_values.0.percentage_allocation", "10"),
+				),
+			},
+		},
+	})
+}
+
 func getFeatureStateImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		uuid, err := getAttributefromState(s, n, "uuid")
@@ -205,6 +260,29 @@ resource "flagsmith_feature_state" "dummy_environment_feature_x_segment_override
 `, projectUUID(), featureName, projectUUID(), isEnabled, environmentKey(), segmentPriority, featureStateValue)
 }
 
+func testAccSegmentFeatureStateWithChangeRequestConfig() string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+
+}
+
+resource "flagsmith_feature_state" "dummy_environment_feature_x_segment_override" {
+  enabled         = true
+  environment_key = "%s"
+  feature_id      = %d
+  segment_id      = 1
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "one"
+  }
+  change_request = {
+    title = "Create segment override"
+  }
+}
+
+`, environmentKey(), featureID())
+}
+
 func testAccEnvironmentFeatureStateResourceConfig(featureStateValue string, isEnabled bool) string {
 	return fmt.Sprintf(`
 provider "flagsmith" {
@@ -224,6 +302,27 @@ resource "flagsmith_feature_state" "dummy_environment_feature_x" {
 
 `, isEnabled, environmentKey(), featureID(), featureStateValue)
 }
+
+func testAccScheduledFeatureStateResourceConfig(featureStateValue string, isEnabled bool, liveFrom string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+
+}
+
+resource "flagsmith_feature_state" "dummy_environment_feature_x" {
+  enabled         = %t
+  environment_key = "%s"
+  feature_id      = %d
+  live_from       = "%s"
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "%s"
+  }
+}
+
+`, isEnabled, environmentKey(), featureID(), liveFrom, featureStateValue)
+}
+
 func testAccInvalidFeatureStateValueConfig() string {
 	return fmt.Sprintf(`
 provider "flagsmith" {
@@ -241,3 +340,95 @@ resource "flagsmith_feature_state" "dummy_environment_feature_x" {
 
 `, environmentKey(), featureID())
 }
+
+// testAccMultivariateFeatureStateResourceConfig allocates percentageA and percentageB to the options in the
+// environment, option a is not listed if percentageA is 0. The segment override allocates everything to option b
+func testAccMultivariateFeatureStateResourceConfig(featureName string, percentageA, percentageB float64) string {
+	optionA := ""
+	if percentageA != 0 {
+		optionA = fmt.Sprintf(`{
+      multivariate_option_uuid = flagsmith_mv_feature_option.option_a.uuid
+      percentage_allocation    = %v
+    },`, percentageA)
+	}
+	return fmt.Sprintf(`
+provider "flagsmith" {
+
+}
+
+resource "flagsmith_feature" "multivariate" {
+  feature_name = "%s"
+  project_uuid = "%s"
+  description  = "feature created for terraform multivariate feature state test"
+  type         = "MULTIVARIATE"
+}
+
+resource "flagsmith_mv_feature_option" "option_a" {
+  type                          = "unicode"
+  feature_uuid                  = flagsmith_feature.multivariate.uuid
+  string_value                  = "a"
+  default_percentage_allocation = 20
+}
+
+resource "flagsmith_mv_feature_option" "option_b" {
+  type                          = "unicode"
+  feature_uuid                  = flagsmith_feature.multivariate.uuid
+  string_value                  = "b"
+  default_percentage_allocation = 30
+}
+
+resource "flagsmith_segment" "multivariate" {
+  name         = "%s"
+  project_uuid = "%s"
+  rules = [
+    {
+      "rules" : [{
+        "conditions" : [{
+          "operator" : "EQUAL",
+          "property" : "device_type",
+          "value" : "mobile"
+        }],
+        "type" : "ANY"
+      }],
+      "type" : "ALL"
+    }
+  ]
+}
+
+resource "flagsmith_feature_state" "multivariate_environment" {
+  enabled         = true
+  environment_key = "%s"
+  feature_id      = flagsmith_feature.multivariate.id
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "control"
+  }
+  multivariate_values = [
+    %s
+    {
+      multivariate_option_uuid = flagsmith_mv_feature_option.option_b.uuid
+      percentage_allocation    = %v
+    },
+  ]
+}
+
+resource "flagsmith_feature_state" "multivariate_segment_override" {
+  enabled         = true
+  environment_key = "%s"
+  feature_id      = flagsmith_feature.multivariate.id
+  segment_id      = flagsmith_segment.multivariate.id
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "control"
+  }
+  multivariate_values = [
+    {
+      multivariate_option_uuid = flagsmith_mv_feature_option.option_b.uuid
+      percentage_allocation    = 100
+    },
+  ]
+  depends_on = [flagsmith_mv_feature_option.option_a]
+}
+
+`, featureName, projectUUID(), featureName, projectUUID(), environmentKey(), optionA, percentageB, environmentKey())
+}
@@ -0,0 +1,446 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &identityResource{}
+var _ resource.ResourceWithImportState = &identityResource{}
+var _ resource.ResourceWithValidateConfig = &identityResource{}
+
+func newIdentityResource() resource.Resource {
+	return &identityResource{}
+}
+
+type identityResource struct {
+	client *Client
+}
+
+func (r *identityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+func (r *identityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+func (t *identityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Identity. Works with both Core and Edge projects",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the identity. Only set for Core(i.e: non edge) projects",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"identity_uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the identity. Only set for Edge projects",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side environment key associated with the environment",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"identifier": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Identifier of the identity, e.g: user id or email",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"traits": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Traits of the identity, keyed by trait key. NOTE: Exactly one of string_value, integer_value, float_value or boolean_value must be set",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the trait value, can be `unicode`, `int`, `float` or `bool`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"unicode", "int", "float", "bool"}...),
+							},
+						},
+						"string_value": schema.StringAttribute{
+							MarkdownDescription: "String value of the trait if the type is `unicode`",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("integer_value"),
+									path.MatchRelative().AtParent().AtName("float_value"),
+									path.MatchRelative().AtParent().AtName("boolean_value"),
+								),
+							},
+						},
+						"integer_value": schema.Int64Attribute{
+							MarkdownDescription: "Integer value of the trait if the type is `int`",
+							Optional:            true,
+						},
+						"float_value": schema.Float64Attribute{
+							MarkdownDescription: "Float value of the trait if the type is `float`",
+							Optional:            true,
+						},
+						"boolean_value": schema.BoolAttribute{
+							MarkdownDescription: "Boolean value of the trait if the type is `bool`",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *identityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var traits types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("traits"), &traits)...)
+	if resp.Diagnostics.HasError() || traits.IsNull() || traits.IsUnknown() {
+		return
+	}
+	for traitKey, element := range traits.Elements() {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		var traitValue TraitValue
+		resp.Diagnostics.Append(element.(types.Object).As(ctx, &traitValue, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := traitValue.ValidateType(); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("traits").AtMapKey(traitKey),
+				"Invalid Trait Value",
+				err.Error(),
+			)
+		}
+	}
+}
+
+func (r *identityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdentityResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	environmentKey := data.EnvironmentKey.ValueString()
+
+	isEdge, err := r.client.IsEdgeEnvironment(environmentKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch environment, got error: %s", err))
+		return
+	}
+
+	data.ID = types.Int64Null()
+	data.IdentityUUID = types.StringNull()
+	if isEdge {
+		edgeIdentity := EdgeIdentity{Identifier: data.Identifier.ValueString()}
+		err = r.client.CreateEdgeIdentity(environmentKey, &edgeIdentity)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create edge identity, got error: %s", err))
+			return
+		}
+		data.IdentityUUID = types.StringValue(edgeIdentity.IdentityUUID)
+	} else {
+		identity := flagsmithapi.Identity{Identifier: data.Identifier.ValueString()}
+		err = r.client.CreateIdentity(environmentKey, &identity)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create identity, got error: %s", err))
+			return
+		}
+		data.ID = types.Int64Value(*identity.ID)
+	}
+	// Save the identity before setting the traits so that a failure below
+	// does not leave an untracked identity behind
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.updateTraits(&data, nil, data.Traits)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set identity traits, got error: %s", err))
+		return
+	}
+}
+
+func (r *identityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdentityResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+	environmentKey := data.EnvironmentKey.ValueString()
+
+	var traits map[string]TraitValue
+	var err error
+
+	switch {
+	case data.IdentityUUID.ValueString() != "":
+		traits, err = r.readEdgeIdentity(&data)
+	case data.ID.ValueInt64() != 0:
+		traits, err = r.readCoreIdentity(&data)
+	default:
+		// We are importing the resource, i.e: only environment key and identifier are known
+		var isEdge bool
+		isEdge, err = r.client.IsEdgeEnvironment(environmentKey)
+		if err != nil {
+			break
+		}
+		if isEdge {
+			traits, err = r.readEdgeIdentity(&data)
+		} else {
+			traits, err = r.readCoreIdentity(&data)
+		}
+	}
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read identity, got error: %s", err))
+		return
+	}
+	// This prevents creating unnecessary plan change(from {} -> nil)
+	// when traits is not part of the plan
+	if data.Traits == nil && len(traits) == 0 {
+		traits = nil
+	}
+	data.Traits = traits
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *identityResource) readCoreIdentity(data *IdentityResourceData) (map[string]TraitValue, error) {
+	environmentKey := data.EnvironmentKey.ValueString()
+
+	var identity *flagsmithapi.Identity
+	var err error
+	if data.ID.ValueInt64() != 0 {
+		identity, err = r.client.GetCoreIdentity(environmentKey, data.ID.ValueInt64())
+	} else {
+		identity, err = r.client.GetCoreIdentityByIdentifier(environmentKey, data.Identifier.ValueString())
+	}
+	if err != nil {
+		return nil, err
+	}
+	data.ID = types.Int64Value(*identity.ID)
+	data.IdentityUUID = types.StringNull()
+	data.Identifier = types.StringValue(identity.Identifier)
+
+	clientTraits, err := r.client.GetTraits(environmentKey, *identity.ID)
+	if err != nil {
+		return nil, err
+	}
+	traits := make(map[string]TraitValue)
+	for _, clientTrait := range clientTraits {
+		traits[clientTrait.TraitKey] = MakeTraitValueFromClientTrait(&clientTrait)
+	}
+	return traits, nil
+}
+
+func (r *identityResource) readEdgeIdentity(data *IdentityResourceData) (map[string]TraitValue, error) {
+	environmentKey := data.EnvironmentKey.ValueString()
+
+	var identity *EdgeIdentity
+	var err error
+	if data.IdentityUUID.ValueString() != "" {
+		identity, err = r.client.GetEdgeIdentity(environmentKey, data.IdentityUUID.ValueString())
+	} else {
+		identity, err = r.client.GetEdgeIdentityByIdentifier(environmentKey, data.Identifier.ValueString())
+	}
+	if err != nil {
+		return nil, err
+	}
+	data.ID = types.Int64Null()
+	data.IdentityUUID = types.StringValue(identity.IdentityUUID)
+	data.Identifier = types.StringValue(identity.Identifier)
+
+	edgeTraits, err := r.client.GetEdgeIdentityTraits(environmentKey, identity.IdentityUUID)
+	if err != nil {
+		return nil, err
+	}
+	traits := make(map[string]TraitValue)
+	for _, edgeTrait := range edgeTraits {
+		traits[edgeTrait.TraitKey] = MakeTraitValueFromEdgeTrait(&edgeTrait)
+	}
+	return traits, nil
+}
+
+func (r *identityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan IdentityResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	// Get current state
+	var state IdentityResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading state data")
+		return
+	}
+	// Load computed data from the state
+	plan.ID = state.ID
+	plan.IdentityUUID = state.IdentityUUID
+
+	err := r.updateTraits(&plan, state.Traits, plan.Traits)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update identity traits, got error: %s", err))
+		return
+	}
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// updateTraits applies the difference between the current and the desired traits
+func (r *identityResource) updateTraits(data *IdentityResourceData, current, desired map[string]TraitValue) error {
+	environmentKey := data.EnvironmentKey.ValueString()
+
+	if data.IdentityUUID.ValueString() != "" {
+		identityUUID := data.IdentityUUID.ValueString()
+		for traitKey := range current {
+			if _, ok := desired[traitKey]; !ok {
+				err := r.client.UpdateEdgeIdentityTrait(environmentKey, identityUUID, &EdgeTrait{TraitKey: traitKey})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		for traitKey, traitValue := range desired {
+			if currentValue, ok := current[traitKey]; ok && currentValue.Equal(traitValue) {
+				continue
+			}
+			err := r.client.UpdateEdgeIdentityTrait(environmentKey, identityUUID, traitValue.ToEdgeTrait(traitKey))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	identityID := data.ID.ValueInt64()
+	// Core traits are updated/deleted by ID
+	traitIDs := make(map[string]int64)
+	if len(current) > 0 {
+		clientTraits, err := r.client.GetTraits(environmentKey, identityID)
+		if err != nil {
+			return err
+		}
+		for _, clientTrait := range clientTraits {
+			traitIDs[clientTrait.TraitKey] = clientTrait.ID
+		}
+	}
+	for traitKey := range current {
+		if _, ok := desired[traitKey]; !ok {
+			if traitID, exists := traitIDs[traitKey]; exists {
+				err := r.client.DeleteTrait(environmentKey, identityID, traitID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for traitKey, traitValue := range desired {
+		clientTrait := traitValue.ToClientTrait(traitKey)
+		traitID, exists := traitIDs[traitKey]
+		if !exists {
+			err := r.client.CreateTrait(environmentKey, identityID, clientTrait)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if currentValue, ok := current[traitKey]; ok && currentValue.Equal(traitValue) {
+			continue
+		}
+		clientTrait.ID = traitID
+		err := r.client.UpdateTrait(environmentKey, identityID, clientTrait)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *identityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state IdentityResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+	var err error
+	if state.IdentityUUID.ValueString() != "" {
+		err = r.client.DeleteEdgeIdentity(state.EnvironmentKey.ValueString(), state.IdentityUUID.ValueString())
+	} else if state.ID.ValueInt64() != 0 {
+		err = r.client.DeleteIdentity(state.EnvironmentKey.ValueString(), state.ID.ValueInt64())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete identity, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *identityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.SplitN(req.ID, ",", 2)
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment,identifier Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), importKey[1])...)
+}
@@ -0,0 +1,352 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &identityFeatureStateResource{}
+var _ resource.ResourceWithImportState = &identityFeatureStateResource{}
+
+func newIdentityFeatureStateResource() resource.Resource {
+	return &identityFeatureStateResource{}
+}
+
+type identityFeatureStateResource struct {
+	client *Client
+}
+
+func (r *identityFeatureStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_feature_state"
+}
+
+func (r *identityFeatureStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+func (t *identityFeatureStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Identity override, i.e: the feature state of a feature for a single identity. Works with both Core and Edge projects",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the featurestate. Only set for Core(i.e: non edge) projects",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the featurestate",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"environment_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client side environment key associated with the environment",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"feature_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the feature",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"identifier": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Identifier of the identity the override applies to",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"identity_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the identity. Only set for Core(i.e: non edge) projects",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"identity_uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the identity. Only set for Edge projects",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Used for enabling/disabling the feature",
+				Required:            true,
+			},
+			"feature_state_value": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Value for the feature State. NOTE: One of string_value, integer_value or boolean_value must be set",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the feature state value, can be `unicode`, `int` or `bool`",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"unicode", "int", "bool"}...),
+						},
+					},
+					"string_value": schema.StringAttribute{
+						MarkdownDescription: "String value of the feature if the type is `unicode`.",
+						Optional:            true,
+						Validators: []validator.String{
+							// Validate string value satisfies the regular expression for no leading or trailing whitespace
+							// but allow empty string
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^\S[\s\S]*\S$|^$`),
+								"Leading and trailing whitespace is not allowed",
+							),
+						},
+					},
+					"integer_value": schema.Int64Attribute{
+						MarkdownDescription: "Integer value of the feature if the type is `int`",
+						Optional:            true,
+					},
+					"boolean_value": schema.BoolAttribute{
+						MarkdownDescription: "Boolean value of the feature if the type is `bool`",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *identityFeatureStateResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("feature_state_value").AtName("string_value"),
+			path.MatchRoot("feature_state_value").AtName("integer_value"),
+			path.MatchRoot("feature_state_value").AtName("boolean_value"),
+		),
+	}
+}
+
+// resolveIdentity loads the ID(Core) or the UUID(Edge) of the identity using the identifier
+func (r *identityFeatureStateResource) resolveIdentity(data *IdentityFeatureStateResourceData) error {
+	if data.IdentityUUID.ValueString() != "" || data.IdentityID.ValueInt64() != 0 {
+		return nil
+	}
+	environmentKey := data.EnvironmentKey.ValueString()
+
+	isEdge, err := r.client.IsEdgeEnvironment(environmentKey)
+	if err != nil {
+		return err
+	}
+	data.IdentityID = types.Int64Null()
+	data.IdentityUUID = types.StringNull()
+
+	if isEdge {
+		identity, err := r.client.GetEdgeIdentityByIdentifier(environmentKey, data.Identifier.ValueString())
+		if err != nil {
+			return err
+		}
+		data.IdentityUUID = types.StringValue(identity.IdentityUUID)
+		return nil
+	}
+	identity, err := r.client.GetCoreIdentityByIdentifier(environmentKey, data.Identifier.ValueString())
+	if err != nil {
+		return err
+	}
+	data.IdentityID = types.Int64Value(*identity.ID)
+	return nil
+}
+
+func (r *identityFeatureStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdentityFeatureStateResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.resolveIdentity(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find identity, got error: %s", err))
+		return
+	}
+	environmentKey := data.EnvironmentKey.ValueString()
+	clientFeatureState := data.ToClientIdentityFS()
+
+	if data.IdentityUUID.ValueString() != "" {
+		err = r.client.CreateEdgeIdentityFeatureState(environmentKey, data.IdentityUUID.ValueString(), clientFeatureState)
+	} else {
+		err = r.client.CreateCoreIdentityFeatureState(environmentKey, data.IdentityID.ValueInt64(), clientFeatureState)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create identity feature state, got error: %s", err))
+		return
+	}
+	resourceData := MakeIdentityFeatureStateResourceDataFromClientFS(clientFeatureState)
+	resourceData.EnvironmentKey = data.EnvironmentKey
+	resourceData.Identifier = data.Identifier
+	resourceData.IdentityID = data.IdentityID
+	resourceData.IdentityUUID = data.IdentityUUID
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *identityFeatureStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdentityFeatureStateResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+	environmentKey := data.EnvironmentKey.ValueString()
+
+	err := r.resolveIdentity(&data)
+	var featureState *IdentityFeatureState
+	if err == nil {
+		switch {
+		case data.IdentityUUID.ValueString() != "" && data.UUID.ValueString() != "":
+			featureState, err = r.client.GetEdgeIdentityFeatureState(environmentKey, data.IdentityUUID.ValueString(), data.UUID.ValueString())
+		case data.IdentityUUID.ValueString() != "":
+			featureState, err = r.client.GetEdgeIdentityFeatureStateByFeature(environmentKey, data.IdentityUUID.ValueString(), data.Feature.ValueInt64())
+		case data.ID.ValueInt64() != 0:
+			featureState, err = r.client.GetCoreIdentityFeatureState(environmentKey, data.IdentityID.ValueInt64(), data.ID.ValueInt64())
+		default:
+			featureState, err = r.client.GetCoreIdentityFeatureStateByFeature(environmentKey, data.IdentityID.ValueInt64(), data.Feature.ValueInt64())
+		}
+	}
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read identity feature state, got error: %s", err))
+		return
+	}
+	resourceData := MakeIdentityFeatureStateResourceDataFromClientFS(featureState)
+	resourceData.EnvironmentKey = data.EnvironmentKey
+	resourceData.Identifier = data.Identifier
+	resourceData.IdentityID = data.IdentityID
+	resourceData.IdentityUUID = data.IdentityUUID
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *identityFeatureStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var plan IdentityFeatureStateResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state IdentityFeatureStateResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	environmentKey := state.EnvironmentKey.ValueString()
+
+	// Generate API request body from plan
+	clientFeatureState := plan.ToClientIdentityFS()
+
+	// Load computed data from the state
+	clientFeatureState.ID = state.ID.ValueInt64()
+	clientFeatureState.UUID = state.UUID.ValueString()
+
+	var err error
+	if state.IdentityUUID.ValueString() != "" {
+		err = r.client.UpdateEdgeIdentityFeatureState(environmentKey, state.IdentityUUID.ValueString(), clientFeatureState)
+	} else {
+		err = r.client.UpdateCoreIdentityFeatureState(environmentKey, state.IdentityID.ValueInt64(), clientFeatureState)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update identity feature state, got error: %s", err))
+		return
+	}
+	resourceData := MakeIdentityFeatureStateResourceDataFromClientFS(clientFeatureState)
+	resourceData.EnvironmentKey = state.EnvironmentKey
+	resourceData.Identifier = state.Identifier
+	resourceData.IdentityID = state.IdentityID
+	resourceData.IdentityUUID = state.IdentityUUID
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *identityFeatureStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state IdentityFeatureStateResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error state data")
+		return
+	}
+	environmentKey := state.EnvironmentKey.ValueString()
+
+	var err error
+	if state.IdentityUUID.ValueString() != "" {
+		err = r.client.DeleteEdgeIdentityFeatureState(environmentKey, state.IdentityUUID.ValueString(), state.UUID.ValueString())
+	} else {
+		err = r.client.DeleteCoreIdentityFeatureState(environmentKey, state.IdentityID.ValueInt64(), state.ID.ValueInt64())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete identity feature state, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *identityFeatureStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 3 || importKey[0] == "" || importKey[1] == "" || importKey[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment,identifier,feature_name Got: %q", req.ID),
+		)
+		return
+	}
+	environmentKey, identifier, featureName := importKey[0], importKey[1], importKey[2]
+
+	environment, err := r.client.GetEnvironment(environmentKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch environment, got error: %s", err))
+		return
+	}
+	feature, err := r.client.GetFeatureByName(environment.ProjectID, featureName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find feature, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_key"), environmentKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identifier)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature_id"), *feature.ID)...)
+}
@@ -0,0 +1,84 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccIdentityFeatureStateResource(t *testing.T) {
+	identifier := acctest.RandString(16)
+	featureName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityResourceDestroy(identifier),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIdentityFeatureStateResourceConfig(identifier, featureName, "vip", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_identity_feature_state.test_override", "environment_key", environmentKey()),
+					resource.TestCheckResourceAttr("flagsmith_identity_feature_state.test_override", "identifier", identifier),
+					resource.TestCheckResourceAttr("flagsmith_identity_feature_state.test_override", "feature_state_value.string_value", "vip"),
+					resource.TestCheckResourceAttr("flagsmith_identity_feature_state.test_override", "enabled", "true"),
+
+					resource.TestCheckResourceAttrSet("flagsmith_identity_feature_state.test_override", "feature_id"),
+					resource.TestCheckResourceAttrSet("flagsmith_identity_feature_state.test_override", "uuid"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_identity_feature_state.test_override",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s,%s,%s", environmentKey(), identifier, featureName),
+			},
+
+			// Update testing
+			{
+				Config: testAccIdentityFeatureStateResourceConfig(identifier, featureName, "regular", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_identity_feature_state.test_override", "identifier", identifier),
+					resource.TestCheckResourceAttr("flagsmith_identity_feature_state.test_override", "feature_state_value.string_value", "regular"),
+					resource.TestCheckResourceAttr("flagsmith_identity_feature_state.test_override", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityFeatureStateResourceConfig(identifier, featureName, featureStateValue string, isEnabled bool) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_feature" "test_feature" {
+  feature_name = "%s"
+  project_uuid = "%s"
+  description  = "feature created for terraform identity override test"
+  type         = "STANDARD"
+}
+
+resource "flagsmith_identity" "test_identity" {
+  environment_key = "%s"
+  identifier      = "%s"
+}
+
+resource "flagsmith_identity_feature_state" "test_override" {
+  environment_key = flagsmith_identity.test_identity.environment_key
+  identifier      = flagsmith_identity.test_identity.identifier
+  feature_id      = flagsmith_feature.test_feature.id
+  enabled         = %t
+  feature_state_value = {
+    type         = "unicode"
+    string_value = "%s"
+  }
+}
+
+`, featureName, projectUUID(), environmentKey(), identifier, isEnabled, featureStateValue)
+}
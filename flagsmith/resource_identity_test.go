@@ -0,0 +1,120 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccIdentityResource(t *testing.T) {
+	identifier := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityResourceDestroy(identifier),
+		Steps: []resource.TestStep{
+			// Test trait value type validation
+			{
+				Config:      testAccIdentityMismatchedTraitConfig(identifier),
+				ExpectError: regexp.MustCompile(`type is "int", hence integer_value must be set`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIdentityResourceConfig(identifier, "mobile", 21),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "identifier", identifier),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "environment_key", environmentKey()),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.device_type.type", "unicode"),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.device_type.string_value", "mobile"),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.age.type", "int"),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.age.integer_value", "21"),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.beta_tester.boolean_value", "true"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_identity.test_identity",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s,%s", environmentKey(), identifier),
+			},
+
+			// Update testing
+			{
+				Config: testAccIdentityResourceConfig(identifier, "desktop", 22),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "identifier", identifier),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.device_type.string_value", "desktop"),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.age.integer_value", "22"),
+					resource.TestCheckResourceAttr("flagsmith_identity.test_identity", "traits.beta_tester.boolean_value", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityResourceDestroy(identifier string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testClient().GetCoreIdentityByIdentifier(environmentKey(), identifier)
+		if err == nil {
+			return fmt.Errorf("identity still exists")
+		}
+		_, err = testClient().GetEdgeIdentityByIdentifier(environmentKey(), identifier)
+		if err == nil {
+			return fmt.Errorf("edge identity still exists")
+		}
+		return nil
+	}
+}
+
+func testAccIdentityResourceConfig(identifier, deviceType string, age int) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_identity" "test_identity" {
+  environment_key = "%s"
+  identifier      = "%s"
+  traits = {
+    device_type = {
+      type         = "unicode"
+      string_value = "%s"
+    }
+    age = {
+      type          = "int"
+      integer_value = %d
+    }
+    beta_tester = {
+      type          = "bool"
+      boolean_value = true
+    }
+  }
+}
+
+`, environmentKey(), identifier, deviceType, age)
+}
+
+func testAccIdentityMismatchedTraitConfig(identifier string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_identity" "test_identity" {
+  environment_key = "%s"
+  identifier      = "%s"
+  traits = {
+    age = {
+      type         = "int"
+      string_value = "21"
+    }
+  }
+}
+
+`, environmentKey(), identifier)
+}
@@ -0,0 +1,281 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &masterAPIKeyResource{}
+var _ resource.ResourceWithImportState = &masterAPIKeyResource{}
+
+func newMasterAPIKeyResource() resource.Resource {
+	return &masterAPIKeyResource{}
+}
+
+type masterAPIKeyResource struct {
+	client *Client
+}
+
+func (r *masterAPIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_master_api_key"
+}
+
+func (r *masterAPIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (t *masterAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Master API Key: used to access the admin API of an organisation. The key is revoked on destroy",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the master API key",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"prefix": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Prefix of the key, used to identify the key",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the master API key",
+			},
+			"expiry_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "RFC3339 timestamp after which the key can not be used anymore",
+				Validators:          []validator.String{rfc3339Validator{}},
+			},
+			"is_admin": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Grants admin access to the organisation. Non admin keys get their permissions from `roles`. If unspecified, it will default to true",
+				Default:             booldefault.StaticBool(true),
+			},
+			"roles": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the roles assigned to the key",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The master API key. It's only returned when the key is created, hence it's not available after import",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// updateRoles assigns(and unassigns) roles to the key to go from `current` to `planned`
+func (r *masterAPIKeyResource) updateRoles(organisationID int64, keyID string, current, planned *[]int64) error {
+	for _, roleID := range Difference(planned, current) {
+		err := r.client.CreateRoleAssignment(organisationID, &RoleAssignment{Role: roleID, MasterAPIKey: &keyID})
+		if err != nil {
+			return err
+		}
+	}
+	for _, roleID := range Difference(current, planned) {
+		assignment, err := r.client.GetRoleAssignment(organisationID, &RoleAssignment{Role: roleID, MasterAPIKey: &keyID})
+		if err != nil {
+			if _, ok := err.(NotFoundError); ok {
+				continue
+			}
+			return err
+		}
+		assignment.Role = roleID
+		err = r.client.DeleteRoleAssignment(organisationID, assignment)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *masterAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MasterAPIKeyResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientKey, err := data.ToClientMasterAPIKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Timestamp", fmt.Sprintf("Unable to parse expiry_date, got error: %s", err))
+		return
+	}
+	err = r.client.CreateMasterAPIKey(clientKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create master api key, got error: %s", err))
+		return
+	}
+	// Save the key right away, otherwise it will be lost if assigning roles fails
+	resourceData := MakeMasterAPIKeyResourceDataFromClientMasterAPIKey(clientKey, &data)
+	resourceData.Roles = nil
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+
+	err = r.updateRoles(data.OrganisationID.ValueInt64(), clientKey.ID, nil, data.RoleIDs())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign roles to master api key, got error: %s", err))
+		return
+	}
+	resourceData.Roles = data.Roles
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *masterAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MasterAPIKeyResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	clientKey, err := r.client.GetMasterAPIKey(data.OrganisationID.ValueInt64(), data.Prefix.ValueString())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read master api key, got error: %s", err))
+		return
+	}
+	// Only the roles managed by terraform are checked
+	if data.Roles != nil {
+		roles := []types.Int64{}
+		for _, role := range *data.Roles {
+			_, err := r.client.GetRoleAssignment(data.OrganisationID.ValueInt64(), &RoleAssignment{Role: role.ValueInt64(), MasterAPIKey: &clientKey.ID})
+			if err != nil {
+				if _, ok := err.(NotFoundError); ok {
+					continue
+				}
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read master api key roles, got error: %s", err))
+				return
+			}
+			roles = append(roles, role)
+		}
+		data.Roles = &roles
+	}
+	resourceData := MakeMasterAPIKeyResourceDataFromClientMasterAPIKey(clientKey, &data)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *masterAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan MasterAPIKeyResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	var state MasterAPIKeyResourceData
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientKey, err := plan.ToClientMasterAPIKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Timestamp", fmt.Sprintf("Unable to parse expiry_date, got error: %s", err))
+		return
+	}
+	err = r.client.UpdateMasterAPIKey(clientKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update master api key, got error: %s", err))
+		return
+	}
+	err = r.updateRoles(plan.OrganisationID.ValueInt64(), plan.ID.ValueString(), state.RoleIDs(), plan.RoleIDs())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update master api key roles, got error: %s", err))
+		return
+	}
+	resourceData := MakeMasterAPIKeyResourceDataFromClientMasterAPIKey(clientKey, &plan)
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *masterAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state MasterAPIKeyResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.RevokeMasterAPIKey(state.OrganisationID.ValueInt64(), state.Prefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke master api key, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *masterAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organisation_id,prefix Got: %q", req.ID),
+		)
+		return
+	}
+	organisationID, err := strconv.ParseInt(importKey[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("organisation_id must be an integer, got: %q", importKey[0]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prefix"), importKey[1])...)
+}
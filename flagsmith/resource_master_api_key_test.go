@@ -0,0 +1,83 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccMasterAPIKeyResource(t *testing.T) {
+	keyName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMasterAPIKeyResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMasterAPIKeyResourceConfig(keyName, "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_master_api_key.test_key", "name", keyName),
+					resource.TestCheckResourceAttr("flagsmith_master_api_key.test_key", "expiry_date", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("flagsmith_master_api_key.test_key", "is_admin", "true"),
+					resource.TestCheckResourceAttrSet("flagsmith_master_api_key.test_key", "prefix"),
+					resource.TestCheckResourceAttrSet("flagsmith_master_api_key.test_key", "key"),
+				),
+			},
+
+			// ImportState testing(the key itself is only returned on creation)
+			{
+				ResourceName:            "flagsmith_master_api_key.test_key",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					prefix, err := getAttributefromState(s, "flagsmith_master_api_key.test_key", "prefix")
+					return fmt.Sprintf("%d,%s", organisationID(), prefix), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccMasterAPIKeyResourceConfig(keyName+"_updated", "2098-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_master_api_key.test_key", "name", keyName+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_master_api_key.test_key", "expiry_date", "2098-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("flagsmith_master_api_key.test_key", "key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMasterAPIKeyResourceDestroy(s *terraform.State) error {
+	prefix, err := getAttributefromState(s, "flagsmith_master_api_key.test_key", "prefix")
+	if err != nil {
+		return err
+	}
+
+	// Revoked keys are reported as not found
+	_, err = testClient().GetMasterAPIKey(int64(organisationID()), prefix)
+	if err == nil {
+		return fmt.Errorf("master api key has not been revoked")
+	}
+	return nil
+}
+
+func testAccMasterAPIKeyResourceConfig(name, expiryDate string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_master_api_key" "test_key" {
+  organisation_id = %d
+  name            = "%s"
+  expiry_date     = "%s"
+}
+
+`, organisationID(), name, expiryDate)
+}
@@ -0,0 +1,86 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccMetadataFieldResource(t *testing.T) {
+	name := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMetadataFieldResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMetadataFieldResourceConfig(name, "int", "first description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_metadata_field.test_field", "organisation_id", strconv.Itoa(organisationID())),
+					resource.TestCheckResourceAttr("flagsmith_metadata_field.test_field", "name", name),
+					resource.TestCheckResourceAttr("flagsmith_metadata_field.test_field", "type", "int"),
+					resource.TestCheckResourceAttr("flagsmith_metadata_field.test_field", "description", "first description"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_metadata_field.test_field",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_metadata_field.test_field", "id")
+					return fmt.Sprintf("%d,%s", organisationID(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccMetadataFieldResourceConfig(name+"_updated", "url", "second description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_metadata_field.test_field", "name", name+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_metadata_field.test_field", "type", "url"),
+					resource.TestCheckResourceAttr("flagsmith_metadata_field.test_field", "description", "second description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMetadataFieldResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_metadata_field.test_field", "id")
+	if err != nil {
+		return err
+	}
+	fieldID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetMetadataField(fieldID)
+	if err == nil {
+		return fmt.Errorf("metadata field still exists")
+	}
+	return nil
+}
+
+func testAccMetadataFieldResourceConfig(name, fieldType, description string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_metadata_field" "test_field" {
+  organisation_id = %d
+  name            = "%s"
+  type            = "%s"
+  description     = "%s"
+}
+
+`, organisationID(), name, fieldType, description)
+}
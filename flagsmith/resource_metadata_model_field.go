@@ -0,0 +1,261 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &metadataModelFieldResource{}
+var _ resource.ResourceWithImportState = &metadataModelFieldResource{}
+
+func newMetadataModelFieldResource() resource.Resource {
+	return &metadataModelFieldResource{}
+}
+
+type metadataModelFieldResource struct {
+	client *Client
+}
+
+func (r *metadataModelFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metadata_model_field"
+}
+
+func (r *metadataModelFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *metadataModelFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Metadata Model Field: attaches a metadata field to features, segments or environments",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the metadata model field",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"field_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the metadata field",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"content_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Model the metadata field is attached to, can be `feature`, `segment` or `environment`",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(metadataContentTypes...),
+				},
+			},
+			"is_required_for": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Organisation and/or projects whose objects must have a value for the metadata field",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Type of the object the requirement applies to, can be `organisation` or `project`",
+							Validators: []validator.String{
+								stringvalidator.OneOf("organisation", "project"),
+							},
+						},
+						"object_id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "ID of the organisation or the project",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// contentTypes returns the content types metadata fields can be attached to, and the ones a metadata field
+// attached to the given model can be required for
+func (r *metadataModelFieldResource) contentTypes(organisationID int64, model string) ([]ContentType, []ContentType, error) {
+	contentTypes, err := r.client.GetMetadataContentTypes(organisationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	requirementContentTypes, err := r.client.GetMetadataRequirementContentTypes(organisationID, model)
+	if err != nil {
+		return nil, nil, err
+	}
+	return contentTypes, requirementContentTypes, nil
+}
+
+func (r *metadataModelFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetadataModelFieldResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID := data.OrganisationID.ValueInt64()
+	contentTypes, requirementContentTypes, err := r.contentTypes(organisationID, data.ContentType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read content types, got error: %s", err))
+		return
+	}
+	clientModelField, err := data.ToClientMetadataModelField(contentTypes, requirementContentTypes)
+	if err == nil {
+		err = r.client.CreateMetadataModelField(organisationID, clientModelField)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metadata model field, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeMetadataModelFieldResourceDataFromClientMetadataModelField(clientModelField, organisationID, contentTypes, requirementContentTypes)
+	if resourceData.IsRequiredFor == nil {
+		resourceData.IsRequiredFor = data.IsRequiredFor
+	}
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *metadataModelFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetadataModelFieldResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	organisationID := data.OrganisationID.ValueInt64()
+	modelField, err := r.client.GetMetadataModelField(organisationID, data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metadata model field, got error: %s", err))
+		return
+	}
+
+	// content_type is not set after import
+	contentTypes, err := r.client.GetMetadataContentTypes(organisationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read content types, got error: %s", err))
+		return
+	}
+	var requirementContentTypes []ContentType
+	for _, contentType := range contentTypes {
+		if contentType.ID == modelField.ContentType {
+			requirementContentTypes, err = r.client.GetMetadataRequirementContentTypes(organisationID, contentType.Model)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read content types, got error: %s", err))
+		return
+	}
+	resourceData := MakeMetadataModelFieldResourceDataFromClientMetadataModelField(modelField, organisationID, contentTypes, requirementContentTypes)
+	// This prevents creating unnecessary plan change(from [] -> nil)
+	if resourceData.IsRequiredFor == nil && data.IsRequiredFor != nil {
+		resourceData.IsRequiredFor = []MetadataModelFieldRequirementData{}
+	}
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *metadataModelFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan MetadataModelFieldResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	organisationID := plan.OrganisationID.ValueInt64()
+	contentTypes, requirementContentTypes, err := r.contentTypes(organisationID, plan.ContentType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read content types, got error: %s", err))
+		return
+	}
+	clientModelField, err := plan.ToClientMetadataModelField(contentTypes, requirementContentTypes)
+	if err == nil {
+		err = r.client.UpdateMetadataModelField(organisationID, clientModelField)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metadata model field, got error: %s", err))
+		return
+	}
+	resourceData := MakeMetadataModelFieldResourceDataFromClientMetadataModelField(clientModelField, organisationID, contentTypes, requirementContentTypes)
+	if resourceData.IsRequiredFor == nil {
+		resourceData.IsRequiredFor = plan.IsRequiredFor
+	}
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *metadataModelFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state MetadataModelFieldResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteMetadataModelField(state.OrganisationID.ValueInt64(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metadata model field, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *metadataModelFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organisationID, modelFieldID, diags := parseOrganisationObjectImportID(req.ID, "model_field_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), modelFieldID)...)
+}
@@ -0,0 +1,109 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccMetadataModelFieldResource(t *testing.T) {
+	name := acctest.RandString(16)
+	featureName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMetadataModelFieldResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMetadataModelFieldResourceConfig(name, featureName, false, "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_metadata_model_field.test_model_field", "organisation_id", strconv.Itoa(organisationID())),
+					resource.TestCheckResourceAttr("flagsmith_metadata_model_field.test_model_field", "content_type", "feature"),
+					resource.TestCheckResourceAttr("flagsmith_metadata_model_field.test_model_field", "is_required_for.#", "0"),
+					resource.TestCheckResourceAttrPair("flagsmith_metadata_model_field.test_model_field", "field_id", "flagsmith_metadata_field.test_field", "id"),
+					resource.TestCheckResourceAttr("flagsmith_feature.test_feature", "metadata."+name, "10"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_metadata_model_field.test_model_field",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_metadata_model_field.test_model_field", "id")
+					return fmt.Sprintf("%d,%s", organisationID(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccMetadataModelFieldResourceConfig(name, featureName, true, "20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_metadata_model_field.test_model_field", "is_required_for.#", "1"),
+					resource.TestCheckResourceAttr("flagsmith_metadata_model_field.test_model_field", "is_required_for.0.content_type", "project"),
+					resource.TestCheckResourceAttr("flagsmith_metadata_model_field.test_model_field", "is_required_for.0.object_id", strconv.Itoa(projectID())),
+					resource.TestCheckResourceAttr("flagsmith_feature.test_feature", "metadata."+name, "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMetadataModelFieldResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_metadata_model_field.test_model_field", "id")
+	if err != nil {
+		return err
+	}
+	modelFieldID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetMetadataModelField(int64(organisationID()), modelFieldID)
+	if err == nil {
+		return fmt.Errorf("metadata model field still exists")
+	}
+	return nil
+}
+
+func testAccMetadataModelFieldResourceConfig(name, featureName string, required bool, value string) string {
+	isRequiredFor := "[]"
+	if required {
+		isRequiredFor = fmt.Sprintf(`[{ content_type = "project", object_id = %d }]`, projectID())
+	}
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_metadata_field" "test_field" {
+  organisation_id = %d
+  name            = "%s"
+  type            = "int"
+}
+
+resource "flagsmith_metadata_model_field" "test_model_field" {
+  organisation_id = %d
+  field_id        = flagsmith_metadata_field.test_field.id
+  content_type    = "feature"
+  is_required_for = %s
+}
+
+resource "flagsmith_feature" "test_feature" {
+  feature_name = "%s"
+  project_uuid = "%s"
+  type         = "STANDARD"
+  metadata = {
+    (flagsmith_metadata_field.test_field.name) = "%s"
+  }
+  depends_on = [flagsmith_metadata_model_field.test_model_field]
+}
+
+`, organisationID(), name, organisationID(), isRequiredFor, featureName, projectUUID(), value)
+}
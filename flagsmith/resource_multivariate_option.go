@@ -30,7 +30,7 @@ func newMultivariateResource() resource.Resource {
 }
 
 type multivariateResource struct {
-	client *flagsmithapi.Client
+	client *Client
 }
 
 func (r *multivariateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -39,11 +39,11 @@ func (r *multivariateResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*flagsmithapi.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *flagsmithapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
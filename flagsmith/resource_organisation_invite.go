@@ -0,0 +1,239 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &organisationInviteResource{}
+var _ resource.ResourceWithImportState = &organisationInviteResource{}
+
+func newOrganisationInviteResource() resource.Resource {
+	return &organisationInviteResource{}
+}
+
+type organisationInviteResource struct {
+	client *Client
+}
+
+func (r *organisationInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organisation_invite"
+}
+
+func (r *organisationInviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organisationInviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Organisation Invite: invites a user to join an organisation by email. " +
+			"Once the invite is accepted, the resource is kept as long as the user is a member of the organisation, " +
+			"use `flagsmith_organisation_user` to manage their role afterwards",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the invite",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Email of the invited user",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Role of the user in the organisation once the invite is accepted, can be `ADMIN` or `USER`",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(organisationRoles...),
+				},
+			},
+			"groups": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the user groups the user is added to once the invite is accepted",
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *organisationInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganisationInviteResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientInvite := data.ToClientOrganisationInvite()
+	err := r.client.CreateOrganisationInvite(clientInvite)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organisation invite, got error: %s", err))
+		return
+	}
+	// The invite is saved even if its ID can not be looked up, so that it's tainted(and looked up again
+	// on refresh) instead of being left behind
+	lookupErr := r.client.FindOrganisationInvite(clientInvite)
+
+	resourceData := MakeOrganisationInviteResourceDataFromClientOrganisationInvite(clientInvite)
+	// Keep the email as configured, the API may change its case
+	resourceData.Email = data.Email
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+
+	if lookupErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organisation invite, got error: %s", lookupErr))
+	}
+}
+
+func (r *organisationInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganisationInviteResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	organisationID := data.OrganisationID.ValueInt64()
+	var invite *OrganisationInvite
+	var err error
+	if data.ID.IsNull() {
+		// The ID could not be looked up on create
+		invite = data.ToClientOrganisationInvite()
+		err = r.client.FindOrganisationInvite(invite)
+	} else {
+		invite, err = r.client.GetOrganisationInvite(organisationID, data.ID.ValueInt64())
+	}
+	if err != nil {
+		if _, ok := err.(NotFoundError); !ok {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organisation invite, got error: %s", err))
+			return
+		}
+		// The invite is deleted once accepted, keep it as long as the user is a member
+		_, err = r.client.GetOrganisationUserByEmail(organisationID, data.Email.ValueString())
+		if err == nil {
+			return
+		}
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organisation user, got error: %s", err))
+		return
+	}
+	resourceData := MakeOrganisationInviteResourceDataFromClientOrganisationInvite(invite)
+	if strings.EqualFold(data.Email.ValueString(), invite.Email) {
+		resourceData.Email = data.Email
+	}
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organisationInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replace, invites can not be updated
+	var plan OrganisationInviteResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organisationInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state OrganisationInviteResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	inviteID := state.ID.ValueInt64()
+	if state.ID.IsNull() {
+		// The ID could not be looked up on create
+		invite := state.ToClientOrganisationInvite()
+		err := r.client.FindOrganisationInvite(invite)
+		if err != nil {
+			if _, ok := err.(NotFoundError); !ok {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organisation invite, got error: %s", err))
+				return
+			}
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		inviteID = *invite.ID
+	}
+
+	// Accepted invites no longer exist, the membership is left untouched
+	err := r.client.DeleteOrganisationInvite(state.OrganisationID.ValueInt64(), inviteID)
+	if err != nil {
+		if _, ok := err.(NotFoundError); !ok {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete organisation invite, got error: %s", err))
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *organisationInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organisationID, inviteID, diags := parseOrganisationObjectImportID(req.ID, "invite_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), inviteID)...)
+}
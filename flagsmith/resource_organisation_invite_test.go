@@ -0,0 +1,94 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccOrganisationInviteResource(t *testing.T) {
+	email := strings.ToLower(acctest.RandString(16)) + "@example.com"
+	groupName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganisationInviteResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganisationInviteResourceConfig(email, groupName, "USER", "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("flagsmith_organisation_invite.test_invite", "id"),
+					resource.TestCheckResourceAttr("flagsmith_organisation_invite.test_invite", "organisation_id", strconv.Itoa(organisationID())),
+					resource.TestCheckResourceAttr("flagsmith_organisation_invite.test_invite", "email", email),
+					resource.TestCheckResourceAttr("flagsmith_organisation_invite.test_invite", "role", "USER"),
+					resource.TestCheckResourceAttr("flagsmith_organisation_invite.test_invite", "groups.#", "0"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_organisation_invite.test_invite",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_organisation_invite.test_invite", "id")
+					return fmt.Sprintf("%d,%s", organisationID(), id), err
+				},
+			},
+
+			// Update(replace) testing
+			{
+				Config: testAccOrganisationInviteResourceConfig(email, groupName, "ADMIN", "[flagsmith_user_group.test_group.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_organisation_invite.test_invite", "role", "ADMIN"),
+					resource.TestCheckResourceAttr("flagsmith_organisation_invite.test_invite", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("flagsmith_organisation_invite.test_invite", "groups.*", "flagsmith_user_group.test_group", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganisationInviteResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_organisation_invite.test_invite", "id")
+	if err != nil {
+		return err
+	}
+	inviteID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetOrganisationInvite(int64(organisationID()), inviteID)
+	if err == nil {
+		return fmt.Errorf("organisation invite still exists")
+	}
+	return nil
+}
+
+func testAccOrganisationInviteResourceConfig(email, groupName, role, groups string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_user_group" "test_group" {
+  organisation_id = %d
+  name            = "%s"
+}
+
+resource "flagsmith_organisation_invite" "test_invite" {
+  organisation_id = %d
+  email           = "%s"
+  role            = "%s"
+  groups          = %s
+}
+
+`, organisationID(), groupName, organisationID(), email, role, groups)
+}
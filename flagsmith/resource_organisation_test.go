@@ -0,0 +1,73 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccOrganisationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganisationResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create(adopt) and Read testing
+			{
+				Config: testAccOrganisationResourceConfig(true, `"first@example.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_organisation.test_org", "id", strconv.Itoa(organisationID())),
+					resource.TestCheckResourceAttr("flagsmith_organisation.test_org", "uuid", organisationUUID()),
+					resource.TestCheckResourceAttrSet("flagsmith_organisation.test_org", "name"),
+					resource.TestCheckResourceAttrSet("flagsmith_organisation.test_org", "force_2fa"),
+					resource.TestCheckResourceAttr("flagsmith_organisation.test_org", "restrict_project_create_to_admin", "true"),
+					resource.TestCheckResourceAttr("flagsmith_organisation.test_org", "webhook_notification_email", "first@example.com"),
+					resource.TestCheckResourceAttr("flagsmith_organisation.test_org", "delete_on_destroy", "false"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_organisation.test_org",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     organisationUUID(),
+			},
+
+			// Update testing
+			{
+				Config: testAccOrganisationResourceConfig(false, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_organisation.test_org", "restrict_project_create_to_admin", "false"),
+					resource.TestCheckNoResourceAttr("flagsmith_organisation.test_org", "webhook_notification_email"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckOrganisationResourceDestroy checks that destroy left the organisation untouched
+func testAccCheckOrganisationResourceDestroy(s *terraform.State) error {
+	_, err := testClient().GetOrganisation(organisationUUID())
+	if err != nil {
+		return fmt.Errorf("organisation should not be deleted: %s", err)
+	}
+	return nil
+}
+
+func testAccOrganisationResourceConfig(restrictProjectCreateToAdmin bool, webhookNotificationEmail string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_organisation" "test_org" {
+  uuid                             = "%s"
+  restrict_project_create_to_admin = %t
+  webhook_notification_email       = %s
+}
+
+`, organisationUUID(), restrictProjectCreateToAdmin, webhookNotificationEmail)
+}
@@ -0,0 +1,205 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &organisationUserResource{}
+var _ resource.ResourceWithImportState = &organisationUserResource{}
+
+func newOrganisationUserResource() resource.Resource {
+	return &organisationUserResource{}
+}
+
+type organisationUserResource struct {
+	client *Client
+}
+
+func (r *organisationUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organisation_user"
+}
+
+func (r *organisationUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organisationUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Organisation User: manages the role of an existing member of an organisation. " +
+			"The user is removed from the organisation on destroy",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the user",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Email of the user, the user must already be a member of the organisation(see `flagsmith_organisation_invite`)",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Role of the user in the organisation, can be `ADMIN` or `USER`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(organisationRoles...),
+				},
+			},
+		},
+	}
+}
+
+func (r *organisationUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganisationUserResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID := data.OrganisationID.ValueInt64()
+	user, err := r.client.GetOrganisationUserByEmail(organisationID, data.Email.ValueString())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"User Not Found",
+				fmt.Sprintf("%s is not a member of the organisation, invite them using `flagsmith_organisation_invite` first", data.Email.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organisation user, got error: %s", err))
+		return
+	}
+	if user.Role != data.Role.ValueString() {
+		err = r.client.UpdateOrganisationUserRole(organisationID, user.ID, data.Role.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organisation user role, got error: %s", err))
+			return
+		}
+		user.Role = data.Role.ValueString()
+	}
+
+	resourceData := MakeOrganisationUserResourceDataFromClientOrganisationUser(user, organisationID)
+	// Keep the email as configured, the API may change its case
+	resourceData.Email = data.Email
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organisationUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganisationUserResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	organisationID := data.OrganisationID.ValueInt64()
+	user, err := r.client.GetOrganisationUser(organisationID, data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organisation user, got error: %s", err))
+		return
+	}
+	resourceData := MakeOrganisationUserResourceDataFromClientOrganisationUser(user, organisationID)
+	if strings.EqualFold(data.Email.ValueString(), user.Email) {
+		resourceData.Email = data.Email
+	}
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organisationUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan OrganisationUserResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	err := r.client.UpdateOrganisationUserRole(plan.OrganisationID.ValueInt64(), plan.ID.ValueInt64(), plan.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organisation user role, got error: %s", err))
+		return
+	}
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organisationUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state OrganisationUserResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.RemoveOrganisationUser(state.OrganisationID.ValueInt64(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove organisation user, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *organisationUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organisationID, userID, diags := parseOrganisationObjectImportID(req.ID, "user_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+}
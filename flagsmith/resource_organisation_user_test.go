@@ -0,0 +1,78 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccOrganisationUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccOrganisationUserPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganisationUserResourceDestroy,
+		Steps: []resource.TestStep{
+			// Users must already be members
+			{
+				Config:      testAccOrganisationUserResourceConfig("not-a-member@example.com", "USER"),
+				ExpectError: regexp.MustCompile(`is not a member of the organisation`),
+			},
+
+			// Create and Read testing
+			{
+				Config: testAccOrganisationUserResourceConfig(organisationUserEmail(), "USER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("flagsmith_organisation_user.test_user", "id"),
+					resource.TestCheckResourceAttr("flagsmith_organisation_user.test_user", "organisation_id", strconv.Itoa(organisationID())),
+					resource.TestCheckResourceAttr("flagsmith_organisation_user.test_user", "email", organisationUserEmail()),
+					resource.TestCheckResourceAttr("flagsmith_organisation_user.test_user", "role", "USER"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_organisation_user.test_user",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_organisation_user.test_user", "id")
+					return fmt.Sprintf("%d,%s", organisationID(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccOrganisationUserResourceConfig(organisationUserEmail(), "ADMIN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_organisation_user.test_user", "role", "ADMIN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganisationUserResourceDestroy(s *terraform.State) error {
+	_, err := testClient().GetOrganisationUserByEmail(int64(organisationID()), organisationUserEmail())
+	if err == nil {
+		return fmt.Errorf("organisation user is still a member")
+	}
+	return nil
+}
+
+func testAccOrganisationUserResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_organisation_user" "test_user" {
+  organisation_id = %d
+  email           = "%s"
+  role            = "%s"
+}
+
+`, organisationID(), email, role)
+}
@@ -0,0 +1,87 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccOrganisationWebhookResource(t *testing.T) {
+	path := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganisationWebhookResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganisationWebhookResourceConfig(path, true, "first-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_organisation_webhook.test_webhook", "organisation_id", strconv.Itoa(organisationID())),
+					resource.TestCheckResourceAttr("flagsmith_organisation_webhook.test_webhook", "url", "https://example.com/"+path),
+					resource.TestCheckResourceAttr("flagsmith_organisation_webhook.test_webhook", "enabled", "true"),
+					resource.TestCheckResourceAttr("flagsmith_organisation_webhook.test_webhook", "secret", "first-secret"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:            "flagsmith_organisation_webhook.test_webhook",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_organisation_webhook.test_webhook", "id")
+					return fmt.Sprintf("%d,%s", organisationID(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccOrganisationWebhookResourceConfig(path+"_updated", false, "second-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_organisation_webhook.test_webhook", "url", "https://example.com/"+path+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_organisation_webhook.test_webhook", "enabled", "false"),
+					resource.TestCheckResourceAttr("flagsmith_organisation_webhook.test_webhook", "secret", "second-secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganisationWebhookResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_organisation_webhook.test_webhook", "id")
+	if err != nil {
+		return err
+	}
+	webhookID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetOrganisationWebhook(int64(organisationID()), webhookID)
+	if err == nil {
+		return fmt.Errorf("organisation webhook still exists")
+	}
+	return nil
+}
+
+func testAccOrganisationWebhookResourceConfig(path string, enabled bool, secret string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_organisation_webhook" "test_webhook" {
+  organisation_id = %d
+  url             = "https://example.com/%s"
+  enabled         = %t
+  secret          = "%s"
+}
+
+`, organisationID(), path, enabled, secret)
+}
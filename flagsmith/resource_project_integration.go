@@ -0,0 +1,300 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &projectIntegrationResource{}
+var _ resource.ResourceWithImportState = &projectIntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &projectIntegrationResource{}
+
+func newProjectIntegrationResource() resource.Resource {
+	return &projectIntegrationResource{}
+}
+
+type projectIntegrationResource struct {
+	client *Client
+}
+
+func (r *projectIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_integration"
+}
+
+func (r *projectIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (t *projectIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Project Integration: sends the flag changes of a project to an APM platform",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the integration",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"project_uuid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "UUID of the project",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the project",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of the integration, can be `datadog`, `new_relic`, `dynatrace` or `grafana`",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectIntegrationTypes...),
+				},
+			},
+			"datadog": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the Datadog integration, required if `type` is `datadog`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Datadog API key",
+					},
+					"base_url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Base URL of the Datadog API, e.g: `https://api.datadoghq.eu/`",
+					},
+					"use_custom_source": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Send the events with `Flagsmith` as the source instead of `My Apps`. If unspecified, it will default to false",
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"new_relic": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the New Relic integration, required if `type` is `new_relic`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "New Relic API key",
+					},
+					"base_url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Base URL of the New Relic API, e.g: `https://api.newrelic.com/`",
+					},
+					"app_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "ID of the New Relic application the deployment markers are added to",
+					},
+				},
+			},
+			"dynatrace": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the Dynatrace integration, required if `type` is `dynatrace`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Dynatrace API token",
+					},
+					"base_url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Base URL of the Dynatrace environment, e.g: `https://<environment_id>.live.dynatrace.com/`",
+					},
+					"entity_selector": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Entity selector of the entities the events are attached to, e.g: `type(SERVICE),tag(flagsmith)`",
+					},
+				},
+			},
+			"grafana": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Configuration of the Grafana integration, required if `type` is `grafana`",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Grafana service account token",
+					},
+					"base_url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Base URL of the Grafana instance, e.g: `https://grafana.example.com/`",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *projectIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateIntegrationBlocks(ctx, req.Config, projectIntegrationTypes)...)
+}
+
+func (r *projectIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectIntegrationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.GetProject(data.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	clientIntegration := data.ToClientProjectIntegration()
+	err = r.client.CreateProjectIntegration(project.ID, data.Type.ValueString(), clientIntegration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project integration, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeProjectIntegrationResourceDataFromClientProjectIntegration(clientIntegration, project.UUID, project.ID, data.Type.ValueString())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectIntegrationResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	projectID := data.ProjectID.ValueInt64()
+	// project_id is not known after import
+	if data.ProjectID.IsNull() {
+		project, err := r.client.GetProject(data.ProjectUUID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			return
+		}
+		projectID = project.ID
+	}
+
+	integration, err := r.client.GetProjectIntegration(projectID, data.Type.ValueString(), data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project integration, got error: %s", err))
+		return
+	}
+	resourceData := MakeProjectIntegrationResourceDataFromClientProjectIntegration(integration, data.ProjectUUID.ValueString(), projectID, data.Type.ValueString())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan ProjectIntegrationResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientIntegration := plan.ToClientProjectIntegration()
+	err := r.client.UpdateProjectIntegration(plan.ProjectID.ValueInt64(), plan.Type.ValueString(), clientIntegration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project integration, got error: %s", err))
+		return
+	}
+	resourceData := MakeProjectIntegrationResourceDataFromClientProjectIntegration(clientIntegration, plan.ProjectUUID.ValueString(), plan.ProjectID.ValueInt64(), plan.Type.ValueString())
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ProjectIntegrationResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteProjectIntegration(state.ProjectID.ValueInt64(), state.Type.ValueString(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project integration, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *projectIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 3 || importKey[0] == "" || importKey[1] == "" || importKey[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_uuid,type,integration_id Got: %q", req.ID),
+		)
+		return
+	}
+	if !slices.Contains(projectIntegrationTypes, importKey[1]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("type must be one of %s, got: %q", strings.Join(projectIntegrationTypes, ", "), importKey[1]),
+		)
+		return
+	}
+	integrationID, err := strconv.ParseInt(importKey[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("integration_id must be an integer, got: %q", importKey[2]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), importKey[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), importKey[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), integrationID)...)
+}
@@ -0,0 +1,97 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccProjectIntegrationResource(t *testing.T) {
+	apiKey := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectIntegrationResourceDestroy,
+		Steps: []resource.TestStep{
+			// Block not matching the type
+			{
+				Config:      testAccProjectIntegrationResourceConfig("grafana", apiKey, false),
+				ExpectError: regexp.MustCompile("`datadog` can not be set when type is \"grafana\""),
+			},
+
+			// Create and Read testing
+			{
+				Config: testAccProjectIntegrationResourceConfig("datadog", apiKey, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "project_uuid", projectUUID()),
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "project_id", strconv.Itoa(projectID())),
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "type", "datadog"),
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "datadog.api_key", apiKey),
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "datadog.base_url", "https://api.datadoghq.eu/"),
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "datadog.use_custom_source", "false"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_project_integration.test_integration",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_project_integration.test_integration", "id")
+					return fmt.Sprintf("%s,datadog,%s", projectUUID(), id), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccProjectIntegrationResourceConfig("datadog", apiKey+"_updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "datadog.api_key", apiKey+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_project_integration.test_integration", "datadog.use_custom_source", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectIntegrationResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_project_integration.test_integration", "id")
+	if err != nil {
+		return err
+	}
+	integrationID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetProjectIntegration(int64(projectID()), "datadog", integrationID)
+	if err == nil {
+		return fmt.Errorf("project integration still exists")
+	}
+	return nil
+}
+
+func testAccProjectIntegrationResourceConfig(integrationType, apiKey string, useCustomSource bool) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_project_integration" "test_integration" {
+  project_uuid = "%s"
+  type         = "%s"
+  datadog = {
+    api_key           = "%s"
+    base_url          = "https://api.datadoghq.eu/"
+    use_custom_source = %t
+  }
+}
+
+`, projectUUID(), integrationType, apiKey, useCustomSource)
+}
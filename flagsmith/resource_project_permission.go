@@ -0,0 +1,273 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &projectPermissionResource{}
+var _ resource.ResourceWithImportState = &projectPermissionResource{}
+var _ resource.ResourceWithModifyPlan = &projectPermissionResource{}
+
+func newProjectPermissionResource() resource.Resource {
+	return &projectPermissionResource{}
+}
+
+type projectPermissionResource struct {
+	client *Client
+}
+
+func (r *projectPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_permission"
+}
+
+func (r *projectPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+func (t *projectPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Project Permission: permissions of a user or a group on a project",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the permission object",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"project_uuid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "UUID of the project",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the project",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user. Exactly one of `user_id` or `group_id` must be set",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("group_id")),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user group. Exactly one of `user_id` or `group_id` must be set",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"admin": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Grants admin access to the project. If unspecified, it will default to false",
+				Default:             booldefault.StaticBool(false),
+			},
+			"permissions": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of permission keys(e.g: `CREATE_FEATURE`, `DELETE_FEATURE`, `MANAGE_SEGMENTS`) granted on the project",
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *projectPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if nothing changes
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+	var permissionSet types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissionSet)...)
+	// The permissions can not be validated until they are known, e.g: they depend on other resources
+	if resp.Diagnostics.HasError() || permissionSet.IsUnknown() {
+		return
+	}
+	var permissions []types.String
+	resp.Diagnostics.Append(permissionSet.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validatePermissionKeys(r.client, "projects", path.Root("permissions"), permissions)...)
+}
+
+func (r *projectPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectPermissionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.GetProject(data.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	clientPermission := data.ToClientPermission()
+	err = r.client.CreateProjectPermission(project.ID, clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project permission, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeProjectPermissionResourceDataFromClientPermission(clientPermission, project.UUID, project.ID)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectPermissionResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	projectID := data.ProjectID.ValueInt64()
+	// project_id is not known after import
+	if data.ProjectID.IsNull() {
+		project, err := r.client.GetProject(data.ProjectUUID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			return
+		}
+		projectID = project.ID
+	}
+
+	clientPermission := data.ToClientPermission()
+	var err error
+	// The permission is looked up by user/group after import
+	if data.ID.IsNull() {
+		clientPermission, err = r.client.FindProjectPermission(projectID, clientPermission.User, clientPermission.Group)
+	} else {
+		clientPermission, err = r.client.GetProjectPermission(projectID, data.ID.ValueInt64(), !data.GroupID.IsNull())
+	}
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project permission, got error: %s", err))
+		return
+	}
+	resourceData := MakeProjectPermissionResourceDataFromClientPermission(clientPermission, data.ProjectUUID.ValueString(), projectID)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan ProjectPermissionResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientPermission := plan.ToClientPermission()
+	err := r.client.UpdateProjectPermission(plan.ProjectID.ValueInt64(), clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project permission, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeProjectPermissionResourceDataFromClientPermission(clientPermission, plan.ProjectUUID.ValueString(), plan.ProjectID.ValueInt64())
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ProjectPermissionResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteProjectPermission(state.ProjectID.ValueInt64(), state.ID.ValueInt64(), !state.GroupID.IsNull())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project permission, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *projectPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectUUID, subjectAttribute, subjectID, diags := parsePermissionImportID(req.ID, "project_uuid")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(subjectAttribute), subjectID)...)
+}
+
+// parsePermissionImportID parses import identifiers of the format: `<object>,user,<user_id>` or `<object>,group,<group_id>`
+// and returns the object, the attribute(`user_id` or `group_id`) and the ID of the user/group
+func parsePermissionImportID(id, objectAttribute string) (string, string, int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	importKey := strings.Split(id, ",")
+	if len(importKey) != 3 || importKey[0] == "" || (importKey[1] != "user" && importKey[1] != "group") {
+		diags.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s,user,user_id or %s,group,group_id Got: %q", objectAttribute, objectAttribute, id),
+		)
+		return "", "", 0, diags
+	}
+	subjectID, err := strconv.ParseInt(importKey[2], 10, 64)
+	if err != nil {
+		diags.AddError("Unexpected Import Identifier", fmt.Sprintf("%s_id must be an integer, got: %q", importKey[1], importKey[2]))
+		return "", "", 0, diags
+	}
+	return importKey[0], importKey[1] + "_id", subjectID, diags
+}
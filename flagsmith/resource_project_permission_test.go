@@ -0,0 +1,153 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccProjectPermissionResourceUser(t *testing.T) {
+	userID := 3936
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectPermissionResourceDestroy("flagsmith_project_permission.test_permission", false),
+		Steps: []resource.TestStep{
+			// Invalid permission key
+			{
+				Config:      testAccProjectPermissionUserResourceConfig(userID, false, `["NOT_A_PERMISSION"]`),
+				ExpectError: regexp.MustCompile(`"NOT_A_PERMISSION" is not a valid permission`),
+			},
+
+			// Create and Read testing
+			{
+				Config: testAccProjectPermissionUserResourceConfig(userID, false, `["CREATE_FEATURE", "DELETE_FEATURE"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "project_uuid", projectUUID()),
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "project_id", fmt.Sprintf("%d", projectID())),
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "user_id", fmt.Sprintf("%d", userID)),
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "admin", "false"),
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_project_permission.test_permission", "permissions.*", "CREATE_FEATURE"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_project_permission.test_permission", "permissions.*", "DELETE_FEATURE"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_project_permission.test_permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s,user,%d", projectUUID(), userID),
+			},
+
+			// Update testing
+			{
+				Config: testAccProjectPermissionUserResourceConfig(userID, true, `["MANAGE_SEGMENTS"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "admin", "true"),
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_project_permission.test_permission", "permissions.*", "MANAGE_SEGMENTS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectPermissionResourceGroup(t *testing.T) {
+	groupName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectPermissionResourceDestroy("flagsmith_project_permission.test_permission", true),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectPermissionGroupResourceConfig(groupName, `["VIEW_PROJECT"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("flagsmith_project_permission.test_permission", "group_id", "flagsmith_user_group.test_group", "id"),
+					resource.TestCheckNoResourceAttr("flagsmith_project_permission.test_permission", "user_id"),
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "permissions.#", "1"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_project_permission.test_permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					groupID, err := getAttributefromState(s, "flagsmith_user_group.test_group", "id")
+					return fmt.Sprintf("%s,group,%s", projectUUID(), groupID), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccProjectPermissionGroupResourceConfig(groupName, `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_project_permission.test_permission", "permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectPermissionResourceDestroy(resourceName string, group bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := getAttributefromState(s, resourceName, "id")
+		if err != nil {
+			return err
+		}
+		permissionID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return err
+		}
+		_, err = testClient().GetProjectPermission(int64(projectID()), permissionID, group)
+		if err == nil {
+			return fmt.Errorf("project permission still exists")
+		}
+		return nil
+	}
+}
+
+func testAccProjectPermissionUserResourceConfig(userID int, admin bool, permissions string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_project_permission" "test_permission" {
+  project_uuid = "%s"
+  user_id      = %d
+  admin        = %t
+  permissions  = %s
+}
+
+`, projectUUID(), userID, admin, permissions)
+}
+
+func testAccProjectPermissionGroupResourceConfig(groupName, permissions string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_user_group" "test_group" {
+  organisation_id = %d
+  name            = "%s"
+}
+
+resource "flagsmith_project_permission" "test_permission" {
+  project_uuid = "%s"
+  group_id     = flagsmith_user_group.test_group.id
+  permissions  = %s
+}
+
+`, organisationID(), groupName, projectUUID(), permissions)
+}
@@ -0,0 +1,71 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccProjectSlackIntegrationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccSlackPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectSlackIntegrationResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectSlackIntegrationResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_project_slack_integration.test_slack", "project_uuid", projectUUID()),
+					resource.TestCheckResourceAttr("flagsmith_project_slack_integration.test_slack", "project_id", strconv.Itoa(projectID())),
+					resource.TestCheckResourceAttrSet("flagsmith_project_slack_integration.test_slack", "id"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:            "flagsmith_project_slack_integration.test_slack",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_project_slack_integration.test_slack", "id")
+					return fmt.Sprintf("%s,%s", projectUUID(), id), err
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckProjectSlackIntegrationResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_project_slack_integration.test_slack", "id")
+	if err != nil {
+		return err
+	}
+	integrationID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetProjectSlackConfiguration(int64(projectID()), integrationID)
+	if err == nil {
+		return fmt.Errorf("project slack integration still exists")
+	}
+	return nil
+}
+
+func testAccProjectSlackIntegrationResourceConfig() string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_project_slack_integration" "test_slack" {
+  project_uuid = "%s"
+  api_token    = "%s"
+}
+
+`, projectUUID(), slackAPIToken())
+}
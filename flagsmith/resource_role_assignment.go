@@ -0,0 +1,207 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &roleAssignmentResource{}
+var _ resource.ResourceWithImportState = &roleAssignmentResource{}
+
+func newRoleAssignmentResource() resource.Resource {
+	return &roleAssignmentResource{}
+}
+
+type roleAssignmentResource struct {
+	client *Client
+}
+
+func (r *roleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignment"
+}
+
+func (r *roleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (t *roleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Role Assignment: binds a role to a user, a group or a master API key",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the role assignment",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"role_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the role",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"user_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user. Exactly one of `user_id`, `group_id` or `master_api_key_id` must be set",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("group_id"), path.MatchRoot("master_api_key_id")),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user group. Exactly one of `user_id`, `group_id` or `master_api_key_id` must be set",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"master_api_key_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the master API key. Exactly one of `user_id`, `group_id` or `master_api_key_id` must be set",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *roleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleAssignmentResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientAssignment := data.ToClientRoleAssignment()
+	err := r.client.CreateRoleAssignment(data.OrganisationID.ValueInt64(), clientAssignment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role assignment, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeRoleAssignmentResourceDataFromClientRoleAssignment(clientAssignment, data.OrganisationID.ValueInt64())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleAssignmentResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	clientAssignment, err := r.client.GetRoleAssignment(data.OrganisationID.ValueInt64(), data.ToClientRoleAssignment())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role assignment, got error: %s", err))
+		return
+	}
+	clientAssignment.Role = data.RoleID.ValueInt64()
+	resourceData := MakeRoleAssignmentResourceDataFromClientRoleAssignment(clientAssignment, data.OrganisationID.ValueInt64())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called since every attribute requires the assignment to be replaced
+func (r *roleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RoleAssignmentResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state RoleAssignmentResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteRoleAssignment(state.OrganisationID.ValueInt64(), state.ToClientRoleAssignment())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role assignment, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *roleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKey := strings.Split(req.ID, ",")
+	if len(importKey) != 4 || importKey[3] == "" || (importKey[2] != "user" && importKey[2] != "group" && importKey[2] != "master_api_key") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organisation_id,role_id,user,user_id or organisation_id,role_id,group,group_id or organisation_id,role_id,master_api_key,master_api_key_id Got: %q", req.ID),
+		)
+		return
+	}
+	organisationID, roleID, diags := parseOrganisationObjectImportID(strings.Join(importKey[:2], ","), "role_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+
+	if importKey[2] == "master_api_key" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("master_api_key_id"), importKey[3])...)
+		return
+	}
+	subjectID, err := strconv.ParseInt(importKey[3], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("%s_id must be an integer, got: %q", importKey[2], importKey[3]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(importKey[2]+"_id"), subjectID)...)
+}
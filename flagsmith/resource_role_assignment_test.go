@@ -0,0 +1,79 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccRoleAssignmentResource(t *testing.T) {
+	name := acctest.RandString(16)
+	userID := 3936
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRoleResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleAssignmentResourceConfig(name, userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_role_assignment.user_assignment", "user_id", fmt.Sprintf("%d", userID)),
+					resource.TestCheckResourceAttrPair("flagsmith_role_assignment.user_assignment", "role_id", "flagsmith_role.test_role", "id"),
+					resource.TestCheckResourceAttrPair("flagsmith_role_assignment.group_assignment", "group_id", "flagsmith_user_group.test_group", "id"),
+					resource.TestCheckNoResourceAttr("flagsmith_role_assignment.group_assignment", "user_id"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_role_assignment.user_assignment",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					roleID, err := getAttributefromState(s, "flagsmith_role.test_role", "id")
+					return fmt.Sprintf("%d,%s,user,%d", organisationID(), roleID, userID), err
+				},
+			},
+			{
+				ResourceName:      "flagsmith_role_assignment.group_assignment",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					roleID, err := getAttributefromState(s, "flagsmith_role.test_role", "id")
+					if err != nil {
+						return "", err
+					}
+					groupID, err := getAttributefromState(s, "flagsmith_user_group.test_group", "id")
+					return fmt.Sprintf("%d,%s,group,%s", organisationID(), roleID, groupID), err
+				},
+			},
+		},
+	})
+}
+
+func testAccRoleAssignmentResourceConfig(name string, userID int) string {
+	return testAccRoleResourceConfig(name, "test role") + fmt.Sprintf(`
+resource "flagsmith_user_group" "test_group" {
+  organisation_id = %d
+  name            = "%s"
+}
+
+resource "flagsmith_role_assignment" "user_assignment" {
+  organisation_id = %d
+  role_id         = flagsmith_role.test_role.id
+  user_id         = %d
+}
+
+resource "flagsmith_role_assignment" "group_assignment" {
+  organisation_id = %d
+  role_id         = flagsmith_role.test_role.id
+  group_id        = flagsmith_user_group.test_group.id
+}
+
+`, organisationID(), name, organisationID(), userID, organisationID())
+}
@@ -0,0 +1,210 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &roleOrganisationPermissionResource{}
+var _ resource.ResourceWithImportState = &roleOrganisationPermissionResource{}
+var _ resource.ResourceWithModifyPlan = &roleOrganisationPermissionResource{}
+
+func newRoleOrganisationPermissionResource() resource.Resource {
+	return &roleOrganisationPermissionResource{}
+}
+
+type roleOrganisationPermissionResource struct {
+	client *Client
+}
+
+func (r *roleOrganisationPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_organisation_permission"
+}
+
+func (r *roleOrganisationPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+func (t *roleOrganisationPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Role Organisation Permission: organisation level permissions of a role",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the permission object",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"role_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the role",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"permissions": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of permission keys(e.g: `CREATE_PROJECT`, `MANAGE_USER_GROUPS`) granted on the organisation",
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *roleOrganisationPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if nothing changes
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+	var permissionSet types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissionSet)...)
+	// The permissions can not be validated until they are known, e.g: they depend on other resources
+	if resp.Diagnostics.HasError() || permissionSet.IsUnknown() {
+		return
+	}
+	var permissions []types.String
+	resp.Diagnostics.Append(permissionSet.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validatePermissionKeys(r.client, "organisations", path.Root("permissions"), permissions)...)
+}
+
+func (r *roleOrganisationPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleOrganisationPermissionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientPermission := data.ToClientRolePermission()
+	err := r.client.CreateRolePermission(data.OrganisationID.ValueInt64(), RoleOrganisationPermissions, clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role organisation permission, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeRoleOrganisationPermissionResourceDataFromClientRolePermission(clientPermission, data.OrganisationID.ValueInt64())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleOrganisationPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleOrganisationPermissionResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	var clientPermission *RolePermission
+	var err error
+	// The permission is looked up by role after import
+	if data.ID.IsNull() {
+		clientPermission, err = r.client.FindRolePermission(data.OrganisationID.ValueInt64(), data.RoleID.ValueInt64(), RoleOrganisationPermissions, 0)
+	} else {
+		clientPermission, err = r.client.GetRolePermission(data.OrganisationID.ValueInt64(), data.RoleID.ValueInt64(), RoleOrganisationPermissions, data.ID.ValueInt64())
+	}
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role organisation permission, got error: %s", err))
+		return
+	}
+	clientPermission.Role = data.RoleID.ValueInt64()
+	resourceData := MakeRoleOrganisationPermissionResourceDataFromClientRolePermission(clientPermission, data.OrganisationID.ValueInt64())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleOrganisationPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan RoleOrganisationPermissionResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientPermission := plan.ToClientRolePermission()
+	err := r.client.UpdateRolePermission(plan.OrganisationID.ValueInt64(), RoleOrganisationPermissions, clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role organisation permission, got error: %s", err))
+		return
+	}
+	resourceData := MakeRoleOrganisationPermissionResourceDataFromClientRolePermission(clientPermission, plan.OrganisationID.ValueInt64())
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleOrganisationPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state RoleOrganisationPermissionResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteRolePermission(state.OrganisationID.ValueInt64(), state.RoleID.ValueInt64(), RoleOrganisationPermissions, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role organisation permission, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *roleOrganisationPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organisationID, roleID, diags := parseOrganisationObjectImportID(req.ID, "role_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+}
@@ -0,0 +1,69 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccRoleOrganisationPermissionResource(t *testing.T) {
+	roleName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRoleResourceDestroy,
+		Steps: []resource.TestStep{
+			// Invalid permission key
+			{
+				Config:      testAccRoleOrganisationPermissionResourceConfig(roleName, `["NOT_A_PERMISSION"]`),
+				ExpectError: regexp.MustCompile(`"NOT_A_PERMISSION" is not a valid permission`),
+			},
+
+			// Create and Read testing
+			{
+				Config: testAccRoleOrganisationPermissionResourceConfig(roleName, `["CREATE_PROJECT"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("flagsmith_role_organisation_permission.test_permission", "role_id", "flagsmith_role.test_role", "id"),
+					resource.TestCheckResourceAttr("flagsmith_role_organisation_permission.test_permission", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_role_organisation_permission.test_permission", "permissions.*", "CREATE_PROJECT"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_role_organisation_permission.test_permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					roleID, err := getAttributefromState(s, "flagsmith_role.test_role", "id")
+					return fmt.Sprintf("%d,%s", organisationID(), roleID), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccRoleOrganisationPermissionResourceConfig(roleName, `["CREATE_PROJECT", "MANAGE_USER_GROUPS"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_role_organisation_permission.test_permission", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_role_organisation_permission.test_permission", "permissions.*", "MANAGE_USER_GROUPS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleOrganisationPermissionResourceConfig(roleName, permissions string) string {
+	return testAccRoleResourceConfig(roleName, "test role") + fmt.Sprintf(`
+resource "flagsmith_role_organisation_permission" "test_permission" {
+  organisation_id = %d
+  role_id         = flagsmith_role.test_role.id
+  permissions     = %s
+}
+
+`, organisationID(), permissions)
+}
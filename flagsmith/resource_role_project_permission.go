@@ -0,0 +1,260 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &roleProjectPermissionResource{}
+var _ resource.ResourceWithImportState = &roleProjectPermissionResource{}
+var _ resource.ResourceWithModifyPlan = &roleProjectPermissionResource{}
+
+func newRoleProjectPermissionResource() resource.Resource {
+	return &roleProjectPermissionResource{}
+}
+
+type roleProjectPermissionResource struct {
+	client *Client
+}
+
+func (r *roleProjectPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_project_permission"
+}
+
+func (r *roleProjectPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+func (t *roleProjectPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith Role Project Permission: permissions of a role on a project",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the permission object",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"role_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the role",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"project_uuid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "UUID of the project",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the project",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"admin": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Grants admin access to the project. If unspecified, it will default to false",
+				Default:             booldefault.StaticBool(false),
+			},
+			"permissions": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of permission keys(e.g: `CREATE_FEATURE`, `DELETE_FEATURE`, `MANAGE_SEGMENTS`) granted on the project",
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *roleProjectPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if nothing changes
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+	var permissionSet types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissionSet)...)
+	// The permissions can not be validated until they are known, e.g: they depend on other resources
+	if resp.Diagnostics.HasError() || permissionSet.IsUnknown() {
+		return
+	}
+	var permissions []types.String
+	resp.Diagnostics.Append(permissionSet.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validatePermissionKeys(r.client, "projects", path.Root("permissions"), permissions)...)
+}
+
+func (r *roleProjectPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleProjectPermissionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.GetProject(data.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	clientPermission := data.ToClientRolePermission()
+	clientPermission.Project = &project.ID
+	err = r.client.CreateRolePermission(data.OrganisationID.ValueInt64(), RoleProjectPermissions, clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role project permission, got error: %s", err))
+		return
+	}
+
+	resourceData := MakeRoleProjectPermissionResourceDataFromClientRolePermission(clientPermission, data.OrganisationID.ValueInt64(), project.UUID)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleProjectPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleProjectPermissionResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	var clientPermission *RolePermission
+	var err error
+	// The permission is looked up by project after import
+	if data.ID.IsNull() {
+		var project *flagsmithapi.Project
+		project, err = r.client.GetProject(data.ProjectUUID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			return
+		}
+		clientPermission, err = r.client.FindRolePermission(data.OrganisationID.ValueInt64(), data.RoleID.ValueInt64(), RoleProjectPermissions, project.ID)
+	} else {
+		clientPermission, err = r.client.GetRolePermission(data.OrganisationID.ValueInt64(), data.RoleID.ValueInt64(), RoleProjectPermissions, data.ID.ValueInt64())
+	}
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role project permission, got error: %s", err))
+		return
+	}
+	clientPermission.Role = data.RoleID.ValueInt64()
+	resourceData := MakeRoleProjectPermissionResourceDataFromClientRolePermission(clientPermission, data.OrganisationID.ValueInt64(), data.ProjectUUID.ValueString())
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleProjectPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan RoleProjectPermissionResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	clientPermission := plan.ToClientRolePermission()
+	err := r.client.UpdateRolePermission(plan.OrganisationID.ValueInt64(), RoleProjectPermissions, clientPermission)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role project permission, got error: %s", err))
+		return
+	}
+	resourceData := MakeRoleProjectPermissionResourceDataFromClientRolePermission(clientPermission, plan.OrganisationID.ValueInt64(), plan.ProjectUUID.ValueString())
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleProjectPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state RoleProjectPermissionResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteRolePermission(state.OrganisationID.ValueInt64(), state.RoleID.ValueInt64(), RoleProjectPermissions, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role project permission, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *roleProjectPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organisationID, roleID, projectUUID, diags := parseRolePermissionImportID(req.ID, "project_uuid")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUUID)...)
+}
+
+// parseRolePermissionImportID parses import identifiers of the format: `<organisation_id>,<role_id>,<object>`
+func parseRolePermissionImportID(id, objectAttribute string) (int64, int64, string, diag.Diagnostics) {
+	importKey := strings.Split(id, ",")
+	if len(importKey) != 3 || importKey[2] == "" {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organisation_id,role_id,%s Got: %q", objectAttribute, id),
+		)
+		return 0, 0, "", diags
+	}
+	organisationID, roleID, diags := parseOrganisationObjectImportID(strings.Join(importKey[:2], ","), "role_id")
+	return organisationID, roleID, importKey[2], diags
+}
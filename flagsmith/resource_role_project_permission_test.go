@@ -0,0 +1,65 @@
+package flagsmith_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccRoleProjectPermissionResource(t *testing.T) {
+	roleName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRoleResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleProjectPermissionResourceConfig(roleName, false, `["CREATE_FEATURE", "MANAGE_SEGMENTS"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_role_project_permission.test_permission", "project_uuid", projectUUID()),
+					resource.TestCheckResourceAttr("flagsmith_role_project_permission.test_permission", "project_id", fmt.Sprintf("%d", projectID())),
+					resource.TestCheckResourceAttr("flagsmith_role_project_permission.test_permission", "admin", "false"),
+					resource.TestCheckResourceAttr("flagsmith_role_project_permission.test_permission", "permissions.#", "2"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_role_project_permission.test_permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					roleID, err := getAttributefromState(s, "flagsmith_role.test_role", "id")
+					return fmt.Sprintf("%d,%s,%s", organisationID(), roleID, projectUUID()), err
+				},
+			},
+
+			// Update testing
+			{
+				Config: testAccRoleProjectPermissionResourceConfig(roleName, true, `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_role_project_permission.test_permission", "admin", "true"),
+					resource.TestCheckResourceAttr("flagsmith_role_project_permission.test_permission", "permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleProjectPermissionResourceConfig(roleName string, admin bool, permissions string) string {
+	return testAccRoleResourceConfig(roleName, "test role") + fmt.Sprintf(`
+resource "flagsmith_role_project_permission" "test_permission" {
+  organisation_id = %d
+  role_id         = flagsmith_role.test_role.id
+  project_uuid    = "%s"
+  admin           = %t
+  permissions     = %s
+}
+
+`, organisationID(), projectUUID(), admin, permissions)
+}
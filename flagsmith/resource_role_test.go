@@ -0,0 +1,91 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccRoleResource(t *testing.T) {
+	roleName := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRoleResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleResourceConfig(roleName, "role description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_role.test_role", "name", roleName),
+					resource.TestCheckResourceAttr("flagsmith_role.test_role", "description", "role description"),
+					resource.TestCheckResourceAttr("flagsmith_role.test_role", "organisation_id", fmt.Sprintf("%d", organisationID())),
+					resource.TestCheckResourceAttrSet("flagsmith_role.test_role", "id"),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_role.test_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRoleImportID("flagsmith_role.test_role"),
+			},
+
+			// Update testing
+			{
+				Config: testAccRoleResourceConfig(roleName+"_updated", "role description updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_role.test_role", "name", roleName+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_role.test_role", "description", "role description updated"),
+				),
+			},
+		},
+	})
+}
+
+func getRoleImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		id, err := getAttributefromState(s, n, "id")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d,%s", organisationID(), id), nil
+	}
+}
+
+func testAccCheckRoleResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_role.test_role", "id")
+	if err != nil {
+		return err
+	}
+	roleID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetRole(int64(organisationID()), roleID)
+	if err == nil {
+		return fmt.Errorf("role still exists")
+	}
+	return nil
+}
+
+func testAccRoleResourceConfig(roleName, description string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_role" "test_role" {
+  organisation_id = %d
+  name            = "%s"
+  description     = "%s"
+}
+
+`, organisationID(), roleName, description)
+}
@@ -14,18 +14,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &segmentResource{}
 var _ resource.ResourceWithImportState = &segmentResource{}
+var _ resource.ResourceWithModifyPlan = &segmentResource{}
 
 func newSegmentResource() resource.Resource {
 	return &segmentResource{}
 }
 
 type segmentResource struct {
-	client *flagsmithapi.Client
+	client *Client
 }
 
 func (r *segmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -38,11 +40,11 @@ func (r *segmentResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*flagsmithapi.Client)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *flagsmithapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -153,11 +155,29 @@ func (t *segmentResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"metadata": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Metadata of the segment, keyed by metadata field name. The fields must be attached to segments, see `flagsmith_metadata_model_field`",
+			},
 		},
 	}
 
 }
 
+func (r *segmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if nothing changes
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var projectUUID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_uuid"), &projectUUID)...)
+	if resp.Diagnostics.HasError() || projectUUID.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(validatePlannedMetadata(ctx, r.client, req.Plan, projectUUID.ValueString(), 0, "segment")...)
+}
+
 func (r *segmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data SegmentResourceData
 
@@ -169,13 +189,23 @@ func (r *segmentResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 	clientSegment := data.ToClientSegment()
 
-	err := r.client.CreateSegment(clientSegment)
+	var err error
+	if data.Metadata == nil {
+		err = r.client.CreateSegment(clientSegment)
+	} else {
+		var metadata []Metadata
+		metadata, err = makeProjectClientMetadata(r.client, data.Metadata, data.ProjectUUID.ValueString(), 0, "segment")
+		if err == nil {
+			err = r.client.CreateSegmentWithMetadata(clientSegment, metadata)
+		}
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create segment, got error: %s", err))
 		return
 	}
 	resourceData := MakeSegmentResourceDataFromClientSegment(clientSegment)
+	resourceData.Metadata = data.Metadata
 
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
@@ -203,6 +233,18 @@ func (r *segmentResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	resourceData := MakeSegmentResourceDataFromClientSegment(segment)
 
+	// Metadata is only managed if set
+	if data.Metadata != nil {
+		metadata, err := r.client.GetSegmentMetadata(*segment.ProjectID, *segment.ID)
+		if err == nil {
+			resourceData.Metadata, err = makeProjectMetadataMap(r.client, metadata, *segment.ProjectID, "segment")
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read segment metadata, got error: %s", err))
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &resourceData)
 	resp.Diagnostics.Append(diags...)
 
@@ -231,13 +273,23 @@ func (r *segmentResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Generate API request body from plan
 	clientSegment := plan.ToClientSegment()
 
-	err := r.client.UpdateSegment(clientSegment)
+	var err error
+	if plan.Metadata == nil && state.Metadata == nil {
+		err = r.client.UpdateSegment(clientSegment)
+	} else {
+		var metadata []Metadata
+		metadata, err = makeProjectClientMetadata(r.client, plan.Metadata, plan.ProjectUUID.ValueString(), 0, "segment")
+		if err == nil {
+			err = r.client.UpdateSegmentWithMetadata(clientSegment, metadata)
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update segment, got error: %s", err))
 		return
 	}
 
 	resourceData := MakeSegmentResourceDataFromClientSegment(clientSegment)
+	resourceData.Metadata = plan.Metadata
 
 	// Update the state with the new values
 	diags = resp.State.Set(ctx, &resourceData)
@@ -0,0 +1,118 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccServerSideEnvironmentKeyResource(t *testing.T) {
+	keyName := acctest.RandString(16)
+	var firstKeyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerSideEnvironmentKeyResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServerSideEnvironmentKeyResourceConfig(keyName, true, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_server_side_environment_key.test_key", "name", keyName),
+					resource.TestCheckResourceAttr("flagsmith_server_side_environment_key.test_key", "active", "true"),
+					resource.TestCheckResourceAttr("flagsmith_server_side_environment_key.test_key", "expires_at", "2099-01-01T00:00:00Z"),
+					resource.TestMatchResourceAttr("flagsmith_server_side_environment_key.test_key", "key", regexp.MustCompile(`^ser\.`)),
+					func(s *terraform.State) error {
+						var err error
+						firstKeyID, err = getAttributefromState(s, "flagsmith_server_side_environment_key.test_key", "id")
+						return err
+					},
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:            "flagsmith_server_side_environment_key.test_key",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keepers"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := getAttributefromState(s, "flagsmith_server_side_environment_key.test_key", "id")
+					return fmt.Sprintf("%s,%s", environmentKey(), id), err
+				},
+			},
+
+			// Update in place
+			{
+				Config: testAccServerSideEnvironmentKeyResourceConfig(keyName+"_updated", false, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_server_side_environment_key.test_key", "name", keyName+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_server_side_environment_key.test_key", "active", "false"),
+					func(s *terraform.State) error {
+						id, err := getAttributefromState(s, "flagsmith_server_side_environment_key.test_key", "id")
+						if err == nil && id != firstKeyID {
+							return fmt.Errorf("expected the key to be updated in place, got a new key")
+						}
+						return err
+					},
+				),
+			},
+
+			// Changing keepers rotates the key
+			{
+				Config: testAccServerSideEnvironmentKeyResourceConfig(keyName+"_updated", false, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_server_side_environment_key.test_key", "keepers.rotation", "v2"),
+					func(s *terraform.State) error {
+						id, err := getAttributefromState(s, "flagsmith_server_side_environment_key.test_key", "id")
+						if err == nil && id == firstKeyID {
+							return fmt.Errorf("expected the key to be rotated")
+						}
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServerSideEnvironmentKeyResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_server_side_environment_key.test_key", "id")
+	if err != nil {
+		return err
+	}
+	keyID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetServerSideEnvKey(environmentKey(), keyID)
+	if err == nil {
+		return fmt.Errorf("server side environment key still exists")
+	}
+	return nil
+}
+
+func testAccServerSideEnvironmentKeyResourceConfig(name string, active bool, rotation string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_server_side_environment_key" "test_key" {
+  environment_key = "%s"
+  name            = "%s"
+  active          = %t
+  expires_at      = "2099-01-01T00:00:00Z"
+  keepers = {
+    rotation = "%s"
+  }
+}
+
+`, environmentKey(), name, active, rotation)
+}
@@ -0,0 +1,243 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &userGroupResource{}
+var _ resource.ResourceWithImportState = &userGroupResource{}
+
+func newUserGroupResource() resource.Resource {
+	return &userGroupResource{}
+}
+
+type userGroupResource struct {
+	client *Client
+}
+
+func (r *userGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group"
+}
+
+func (r *userGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *flagsmith.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+func (t *userGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Flagsmith User Group",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the user group",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"organisation_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the organisation the group belongs to",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the user group",
+			},
+			"external_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the group in an external identity provider(e.g: LDAP/SAML), used for syncing group membership",
+			},
+			"is_default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "If true, new users joining the organisation are added to the group automatically. If unspecified, it will default to false",
+				Default:             booldefault.StaticBool(false),
+			},
+			"users": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.Int64Type,
+				MarkdownDescription: "List of user IDs representing the members of the group. If unspecified, the members of the group " +
+					"are not managed(e.g: they are synced from an identity provider), set it to `[]` to remove all members",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *userGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserGroupResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Config does not apply the default value
+	data.IsDefault = types.BoolValue(data.IsDefault.ValueBool())
+
+	clientGroup := data.ToClientUserGroup()
+	users := clientGroup.Users
+
+	// Create the group
+	err := r.client.CreateUserGroup(clientGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user group, got error: %s", err))
+		return
+	}
+	if users != nil && len(*users) > 0 {
+		err := r.client.AddUserGroupMembers(clientGroup, *users)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add users to user group, got error: %s", err))
+			return
+		}
+	}
+	// Otherwise, the members are the ones returned on create
+	if users != nil {
+		clientGroup.Users = users
+	}
+	resourceData := MakeUserGroupResourceDataFromClientUserGroup(clientGroup)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserGroupResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Early return if the state is wrong
+	if diags.HasError() {
+		return
+	}
+
+	group, err := r.client.GetUserGroup(data.OrganisationID.ValueInt64(), data.ID.ValueInt64())
+	if err != nil {
+		if _, ok := err.(NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user group, got error: %s", err))
+		return
+	}
+	resourceData := MakeUserGroupResourceDataFromClientUserGroup(group)
+
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	//Get plan values
+	var plan UserGroupResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update: Error reading plan data")
+		return
+	}
+
+	// The members are only managed if they are part of the config, the plan contains the
+	// prior members otherwise
+	var configUsers *[]types.Int64
+	diags = req.Config.GetAttribute(ctx, path.Root("users"), &configUsers)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	clientGroup := plan.ToClientUserGroup()
+	planUsers := clientGroup.Users
+
+	// The response contains the current members of the group
+	err := r.client.UpdateUserGroup(clientGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user group, got error: %s", err))
+		return
+	}
+	if configUsers == nil {
+		planUsers = clientGroup.Users
+	}
+
+	// Update group members
+	userIDsToRemove := Difference(clientGroup.Users, planUsers)
+	if len(userIDsToRemove) > 0 {
+		err := r.client.RemoveUserGroupMembers(clientGroup, userIDsToRemove)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user group members, got error: %s", err))
+			return
+		}
+	}
+	userIDsToAdd := Difference(planUsers, clientGroup.Users)
+	if len(userIDsToAdd) > 0 {
+		err := r.client.AddUserGroupMembers(clientGroup, userIDsToAdd)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add user group members, got error: %s", err))
+			return
+		}
+	}
+	clientGroup.Users = planUsers
+	resourceData := MakeUserGroupResourceDataFromClientUserGroup(clientGroup)
+
+	// Update the state with the new values
+	diags = resp.State.Set(ctx, &resourceData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state UserGroupResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete: Error reading state data")
+		return
+	}
+
+	err := r.client.DeleteUserGroup(state.OrganisationID.ValueInt64(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user group, got error: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *userGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organisationID, groupID, diags := parseOrganisationObjectImportID(req.ID, "group_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupID)...)
+}
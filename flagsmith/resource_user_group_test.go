@@ -0,0 +1,133 @@
+package flagsmith_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccUserGroupResource(t *testing.T) {
+	groupName := acctest.RandString(16)
+	firstUserID := 3936
+	secondUserID := 12662
+	thirdUserID := 11871
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserGroupResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserGroupResourceConfig(groupName, false, []int{firstUserID, secondUserID}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "name", groupName),
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "organisation_id", fmt.Sprintf("%d", organisationID())),
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "is_default", "false"),
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_user_group.test_group", "users.*", fmt.Sprintf("%d", firstUserID)),
+					resource.TestCheckTypeSetElemAttr("flagsmith_user_group.test_group", "users.*", fmt.Sprintf("%d", secondUserID)),
+				),
+			},
+
+			// ImportState testing
+			{
+				ResourceName:      "flagsmith_user_group.test_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getUserGroupImportID("flagsmith_user_group.test_group"),
+			},
+
+			// Update testing
+			{
+				Config: testAccUserGroupResourceConfig(groupName+"_updated", true, []int{firstUserID, thirdUserID}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "name", groupName+"_updated"),
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "is_default", "true"),
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_user_group.test_group", "users.*", fmt.Sprintf("%d", firstUserID)),
+					resource.TestCheckTypeSetElemAttr("flagsmith_user_group.test_group", "users.*", fmt.Sprintf("%d", thirdUserID)),
+				),
+			},
+
+			// Users left out of the config are not managed
+			{
+				Config: testAccUserGroupResourceConfigWithoutUsers(groupName + "_updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr("flagsmith_user_group.test_group", "users.*", fmt.Sprintf("%d", firstUserID)),
+					resource.TestCheckTypeSetElemAttr("flagsmith_user_group.test_group", "users.*", fmt.Sprintf("%d", thirdUserID)),
+				),
+			},
+
+			// Remove all users
+			{
+				Config: testAccUserGroupResourceConfig(groupName+"_updated", true, []int{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("flagsmith_user_group.test_group", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func getUserGroupImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		id, err := getAttributefromState(s, n, "id")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d,%s", organisationID(), id), nil
+	}
+}
+
+func testAccCheckUserGroupResourceDestroy(s *terraform.State) error {
+	id, err := getAttributefromState(s, "flagsmith_user_group.test_group", "id")
+	if err != nil {
+		return err
+	}
+	groupID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = testClient().GetUserGroup(int64(organisationID()), groupID)
+	if err == nil {
+		return fmt.Errorf("user group still exists")
+	}
+	return nil
+}
+
+func testAccUserGroupResourceConfig(groupName string, isDefault bool, users []int) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_user_group" "test_group" {
+  organisation_id = %d
+  name            = "%s"
+  is_default      = %t
+  users           = %s
+}
+
+`, organisationID(), groupName, isDefault, strings.Join(strings.Fields(fmt.Sprint(users)), ","))
+}
+
+func testAccUserGroupResourceConfigWithoutUsers(groupName string) string {
+	return fmt.Sprintf(`
+provider "flagsmith" {
+}
+
+resource "flagsmith_user_group" "test_group" {
+  organisation_id = %d
+  name            = "%s"
+  is_default      = true
+}
+
+`, organisationID(), groupName)
+}
@@ -1,5 +1,20 @@
 package flagsmith
 
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Flagsmith/flagsmith-go-api-client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
 // Difference returns a slice of 64-bit integers containing the elements of a that are not present in b.
 // If a or b is nil, they are treated as empty slices.
 func Difference(a, b *[]int64) []int64 {
@@ -24,3 +39,194 @@ func Difference(a, b *[]int64) []int64 {
 	}
 	return result
 }
+
+// validatePermissionKeys checks the given permission keys against the ones
+// advertised by the `permissions` endpoint of the given scope(e.g: `projects`)
+func validatePermissionKeys(client *Client, scope string, attributePath path.Path, keys []types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(keys) == 0 {
+		return diags
+	}
+	availablePermissions, err := client.GetAvailablePermissions(scope)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to fetch available permissions, got error: %s", err))
+		return diags
+	}
+	validKeys := []string{}
+	for _, permission := range availablePermissions {
+		validKeys = append(validKeys, permission.Key)
+	}
+	for _, key := range keys {
+		if key.IsUnknown() || key.IsNull() {
+			continue
+		}
+		if !slices.Contains(validKeys, key.ValueString()) {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid Permission",
+				fmt.Sprintf("%q is not a valid permission, expected one of: %s", key.ValueString(), strings.Join(validKeys, ", ")),
+			)
+		}
+	}
+	return diags
+}
+
+// parseOrganisationObjectImportID parses import identifiers of the format: `<organisation_id>,<object_id>`
+func parseOrganisationObjectImportID(id, objectAttribute string) (int64, int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	importKey := strings.Split(id, ",")
+	if len(importKey) != 2 || importKey[0] == "" || importKey[1] == "" {
+		diags.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organisation_id,%s Got: %q", objectAttribute, id),
+		)
+		return 0, 0, diags
+	}
+	organisationID, err := strconv.ParseInt(importKey[0], 10, 64)
+	if err != nil {
+		diags.AddError("Unexpected Import Identifier", fmt.Sprintf("organisation_id must be an integer, got: %q", importKey[0]))
+		return 0, 0, diags
+	}
+	objectID, err := strconv.ParseInt(importKey[1], 10, 64)
+	if err != nil {
+		diags.AddError("Unexpected Import Identifier", fmt.Sprintf("%s must be an integer, got: %q", objectAttribute, importKey[1]))
+		return 0, 0, diags
+	}
+	return organisationID, objectID, diags
+}
+
+// validateIntegrationBlocks validates that, out of the configuration blocks named after the
+// integration types, exactly the one matching the `type` attribute is set
+func validateIntegrationBlocks(ctx context.Context, config tfsdk.Config, integrationTypes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var integrationType types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("type"), &integrationType)...)
+	if diags.HasError() || integrationType.IsNull() || integrationType.IsUnknown() {
+		return diags
+	}
+
+	for _, blockName := range integrationTypes {
+		var block types.Object
+		diags.Append(config.GetAttribute(ctx, path.Root(blockName), &block)...)
+		if diags.HasError() {
+			return diags
+		}
+		if blockName == integrationType.ValueString() && block.IsNull() {
+			diags.AddAttributeError(
+				path.Root(blockName),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("`%s` must be set when type is %q", blockName, integrationType.ValueString()),
+			)
+		}
+		if blockName != integrationType.ValueString() && !block.IsNull() {
+			diags.AddAttributeError(
+				path.Root(blockName),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("`%s` can not be set when type is %q", blockName, integrationType.ValueString()),
+			)
+		}
+	}
+	return diags
+}
+
+// projectMetadataFields returns the metadata fields of the organisation of the project, as seen by the
+// given model(i.e: `feature`, `segment` or `environment`). The project is looked up by UUID, if set
+func projectMetadataFields(client *Client, projectUUID string, projectID int64, model string) ([]ContentTypeMetadataField, error) {
+	var project *flagsmithapi.Project
+	var err error
+	if projectUUID != "" {
+		project, err = client.GetProject(projectUUID)
+	} else {
+		project, err = client.GetProjectByID(projectID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client.GetContentTypeMetadataFields(project.Organisation, model)
+}
+
+// validatePlannedMetadata checks the planned `metadata` against the metadata fields of the organisation,
+// i.e: the fields exist, are attached to the model and the values match the type of the fields
+func validatePlannedMetadata(ctx context.Context, client *Client, plan tfsdk.Plan, projectUUID string, projectID int64, model string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var metadata types.Map
+	diags.Append(plan.GetAttribute(ctx, path.Root("metadata"), &metadata)...)
+	if diags.HasError() || metadata.IsNull() || metadata.IsUnknown() || len(metadata.Elements()) == 0 {
+		return diags
+	}
+	fields, err := projectMetadataFields(client, projectUUID, projectID, model)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read metadata fields, got error: %s", err))
+		return diags
+	}
+	elements := metadata.Elements()
+	names := []string{}
+	for name := range elements {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		value, ok := elements[name].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := makeClientMetadataValue(name, value.ValueString(), fields, model); err != nil {
+			diags.AddAttributeError(path.Root("metadata").AtMapKey(name), "Invalid Metadata", err.Error())
+		}
+	}
+	return diags
+}
+
+// makeProjectClientMetadata converts the `metadata` map of an object(i.e: model) of the given project
+func makeProjectClientMetadata(client *Client, metadata map[string]types.String, projectUUID string, projectID int64, model string) ([]Metadata, error) {
+	if len(metadata) == 0 {
+		return []Metadata{}, nil
+	}
+	fields, err := projectMetadataFields(client, projectUUID, projectID, model)
+	if err != nil {
+		return nil, err
+	}
+	return makeClientMetadata(metadata, fields, model)
+}
+
+// makeProjectMetadataMap converts the metadata of an object(i.e: model) of the given project into a `metadata` map
+func makeProjectMetadataMap(client *Client, clientMetadata []Metadata, projectID int64, model string) (map[string]types.String, error) {
+	if len(clientMetadata) == 0 {
+		return map[string]types.String{}, nil
+	}
+	fields, err := projectMetadataFields(client, "", projectID, model)
+	if err != nil {
+		return nil, err
+	}
+	return makeMetadataMap(clientMetadata, fields), nil
+}
+
+// validateUniqueSegmentOverrides validates that a segment is overridden at most once in `segment_overrides`
+func validateUniqueSegmentOverrides(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var overrides types.List
+	diags := config.GetAttribute(ctx, path.Root("segment_overrides"), &overrides)
+	if diags.HasError() || overrides.IsNull() || overrides.IsUnknown() {
+		return diags
+	}
+	// Elements are checked one by one since any of them might not be known yet
+	var segments []int64
+	for i, element := range overrides.Elements() {
+		override, ok := element.(types.Object)
+		if !ok || override.IsNull() || override.IsUnknown() {
+			continue
+		}
+		segment, ok := override.Attributes()["segment_id"].(types.Int64)
+		if !ok || segment.IsNull() || segment.IsUnknown() {
+			continue
+		}
+		if slices.Contains(segments, segment.ValueInt64()) {
+			diags.AddAttributeError(
+				path.Root("segment_overrides").AtListIndex(i).AtName("segment_id"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Segment %d is overridden more than once", segment.ValueInt64()),
+			)
+		}
+		segments = append(segments, segment.ValueInt64())
+	}
+	return diags
+}
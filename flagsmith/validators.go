@@ -0,0 +1,113 @@
+package flagsmith
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator validates that a string is a RFC3339 timestamp(e.g: `2024-01-02T15:04:05Z`)
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be a RFC3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a RFC3339 timestamp(e.g: `2024-01-02T15:04:05Z`)"
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Expected a RFC3339 timestamp(e.g: 2024-01-02T15:04:05Z), got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a positive Go duration(e.g: `30m`, `1h30m`)
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration(e.g: `30m`, `1h30m`)"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if duration, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a positive duration(e.g: 30m, 1h30m), got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.List = multivariateValuesValidator{}
+
+// multivariateValuesValidator validates that a list of multivariate values does not list an option twice and
+// that the percentage allocations add up to at most 100
+type multivariateValuesValidator struct{}
+
+func (v multivariateValuesValidator) Description(ctx context.Context) string {
+	return "options must be unique and percentage allocations must add up to at most 100"
+}
+
+func (v multivariateValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return "options must be unique and `percentage_allocation` must add up to at most 100"
+}
+
+func (v multivariateValuesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	// Sum the percentages in hundredths, as float sums like 0.2 + 83.9 + 15.9 come out above 100
+	var total int64
+	optionUUIDs := map[string]bool{}
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.Object)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		attributes := value.Attributes()
+		if optionUUID, ok := attributes["multivariate_option_uuid"].(types.String); ok && !optionUUID.IsNull() && !optionUUID.IsUnknown() {
+			if optionUUIDs[optionUUID.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Duplicate Multivariate Option",
+					fmt.Sprintf("Multivariate option %q is listed more than once", optionUUID.ValueString()),
+				)
+			}
+			optionUUIDs[optionUUID.ValueString()] = true
+		}
+		if percentage, ok := attributes["percentage_allocation"].(types.Float64); ok {
+			total += int64(math.Round(percentage.ValueFloat64() * 100))
+		}
+	}
+	if total > 100*100 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Percentage Allocation",
+			fmt.Sprintf("Percentage allocations of the multivariate options must add up to at most 100, got: %v", float64(total)/100),
+		)
+	}
+}
@@ -0,0 +1,50 @@
+package flagsmith
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeMultivariateValuesList(t *testing.T, percentages ...float64) types.List {
+	attributeTypes := map[string]attr.Type{
+		"multivariate_option_uuid": types.StringType,
+		"percentage_allocation":    types.Float64Type,
+	}
+	elements := []attr.Value{}
+	for i, percentage := range percentages {
+		element, diags := types.ObjectValue(attributeTypes, map[string]attr.Value{
+			"multivariate_option_uuid": types.StringValue(string(rune('a' + i))),
+			"percentage_allocation":    types.Float64Value(percentage),
+		})
+		assert.False(t, diags.HasError())
+		elements = append(elements, element)
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, elements)
+	assert.False(t, diags.HasError())
+	return list
+}
+
+func TestMultivariateValuesValidator(t *testing.T) {
+	// Given
+	v := multivariateValuesValidator{}
+
+	// When the percentages add up to 100, with a float sum above 100
+	resp := validator.ListResponse{}
+	v.ValidateList(context.Background(), validator.ListRequest{Path: path.Root("multivariate_values"), ConfigValue: makeMultivariateValuesList(t, 0.2, 83.9, 15.9)}, &resp)
+
+	// Then
+	assert.False(t, resp.Diagnostics.HasError())
+
+	// When the percentages add up to more than 100
+	resp = validator.ListResponse{}
+	v.ValidateList(context.Background(), validator.ListRequest{Path: path.Root("multivariate_values"), ConfigValue: makeMultivariateValuesList(t, 0.2, 84, 15.9)}, &resp)
+
+	// Then
+	assert.True(t, resp.Diagnostics.HasError())
+}